@@ -0,0 +1,69 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"time"
+)
+
+// Accrual is the interest accrued on a principal over a range of days.
+type Accrual struct {
+	// Total is the interest of the whole range, rounded once.
+	Total *Money
+	// Daily holds the interest of every calendar day of the range, adding up to Total exactly.
+	Daily []*Money
+}
+
+// Accrue returns the interest on principal at the annual rate from start to end, end excluded, counting days with
+// the given day count, e.g. Actual360, Actual365 or Thirty360. Daily amounts are the differences of the running
+// total rounded with mode, so that each day is rounded and the days still add up to the total of the range,
+// which is rounded with mode as well. With Thirty360 some days accrue nothing, like the 31st, and others several
+// days, like the last day of February.
+//
+//	// Interest of a 3.5% deposit in March, rounded down every day like most banks do.
+//	a, err := money.Accrue(deposit, money.Rate{35, 1000}, money.Actual365, march1, april1, money.RoundDown)
+func Accrue(principal *Money, annual Rate, dc DayCount, start, end time.Time, mode RoundingMode) (*Accrual, error) {
+	if err := annual.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := dc.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := mode.validate(); err != nil {
+		return nil, err
+	}
+
+	n := Days(start, end)
+	if n < 0 {
+		return nil, errors.New("end must not be before start")
+	}
+
+	a := &Accrual{Daily: make([]*Money, n)}
+	var prev Amount
+	for i := int64(0); i <= n; i++ {
+		days := dc.days(start, start.AddDate(0, 0, int(i)))
+
+		// principal * annual * days / days per year, rounded once.
+		var cum Amount
+		if !annual.IsZero() {
+			n := new(big.Int).Mul(big.NewInt(principal.amount), big.NewInt(annual.Numerator))
+			n.Mul(n, new(big.Int).Mul(big.NewInt(days), big.NewInt(dc.DaysPerYear.Denominator)))
+			d := new(big.Int).Mul(big.NewInt(annual.Denominator), big.NewInt(dc.DaysPerYear.Numerator))
+
+			var err error
+			if cum, err = roundRat(new(big.Rat).SetFrac(n, d), mode); err != nil {
+				return nil, err
+			}
+		}
+
+		if i > 0 {
+			a.Daily[i-1] = &Money{amount: mutate.calc.subtract(cum, prev), currency: principal.currency}
+		}
+		prev = cum
+	}
+
+	a.Total = &Money{amount: prev, currency: principal.currency}
+	return a, nil
+}
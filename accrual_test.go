@@ -0,0 +1,83 @@
+package money
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAccrue(t *testing.T) {
+	tcs := []struct {
+		principal  int64
+		annual     Rate
+		dc         DayCount
+		start, end time.Time
+		mode       RoundingMode
+		total      int64
+		daily      []int64
+	}{
+		// 1,000,000.00 at 3.6% is exactly 100.00 a day over 360 days.
+		{100000000, Rate{36, 1000}, Actual360, date(2024, time.March, 1), date(2024, time.March, 4), RoundHalfUp, 30000, []int64{10000, 10000, 10000}},
+		// 10,000.00 at 3.5% is 0.9589 a day over 365 days, truncated on the running total.
+		{1000000, Rate{35, 1000}, Actual365, date(2023, time.March, 1), date(2023, time.April, 1), RoundDown, 2972, []int64{95, 96, 96, 96}},
+		// 36,000.00 at 10% is 10.00 a day of 30/360: nothing for the 31st, 3 days for the last of February.
+		{3600000, Rate{10, 100}, Thirty360, date(2023, time.January, 30), date(2023, time.March, 1), RoundHalfUp, 31000, []int64{0, 1000, 1000}},
+		{-1000000, Rate{35, 1000}, Actual365, date(2023, time.March, 1), date(2023, time.March, 3), RoundHalfUp, -192, []int64{-96, -96}},
+		{1000000, Rate{}, Actual365, date(2023, time.March, 1), date(2023, time.March, 3), RoundHalfUp, 0, []int64{0, 0}},
+		{1000000, Rate{35, 1000}, Actual365, date(2023, time.March, 1), date(2023, time.March, 1), RoundHalfUp, 0, []int64{}},
+		// 14,610.00 at 10% is exactly 4.00 a day over 365.25 days.
+		{1461000, Rate{10, 100}, Actual36525, date(2024, time.February, 28), date(2024, time.March, 2), RoundHalfUp, 1200, []int64{400, 400, 400}},
+	}
+
+	for _, tc := range tcs {
+		p, _ := New(tc.principal, EUR)
+		a, err := Accrue(p, tc.annual, tc.dc, tc.start, tc.end, tc.mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.Total.amount != tc.total || int64(len(a.Daily)) != Days(tc.start, tc.end) {
+			t.Errorf("Expected total %d over %d days in %+v got %d over %d", tc.total, Days(tc.start, tc.end), tc.dc, a.Total.amount, len(a.Daily))
+		}
+
+		var sum int64
+		for i, d := range a.Daily {
+			sum += d.amount
+			if i < len(tc.daily) && d.amount != tc.daily[i] {
+				t.Errorf("Expected day %d to accrue %d in %+v got %d", i, tc.daily[i], tc.dc, d.amount)
+			}
+		}
+
+		if sum != a.Total.amount {
+			t.Errorf("Expected days to add up to %d in %+v got %d", a.Total.amount, tc.dc, sum)
+		}
+	}
+}
+
+func TestAccrue_30360(t *testing.T) {
+	p, _ := New(3600000, EUR)
+	a, _ := Accrue(p, Rate{10, 100}, Thirty360, date(2023, time.January, 30), date(2023, time.March, 1), RoundHalfUp)
+	if last := a.Daily[len(a.Daily)-1]; last.amount != 3000 {
+		t.Errorf("Expected the last day of February to accrue 3000 got %d", last.amount)
+	}
+}
+
+func TestAccrue_Errors(t *testing.T) {
+	p, _ := New(100, EUR)
+	start, end := date(2023, time.March, 1), date(2023, time.March, 3)
+
+	if _, err := Accrue(p, Rate{1, 0}, Actual360, start, end, RoundHalfUp); err == nil {
+		t.Error("Expected error for invalid rate")
+	}
+
+	if _, err := Accrue(p, Rate{1, 100}, DayCount{}, start, end, RoundHalfUp); err == nil {
+		t.Error("Expected error for invalid day count")
+	}
+
+	if _, err := Accrue(p, Rate{1, 100}, Actual360, start, end, RoundingMode(42)); err == nil {
+		t.Error("Expected error for unknown rounding mode")
+	}
+
+	if _, err := Accrue(p, Rate{1, 100}, Actual360, end, start, RoundHalfUp); err == nil {
+		t.Error("Expected error for end before start")
+	}
+}
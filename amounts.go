@@ -0,0 +1,141 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Amounts keeps one total per currency, like the balances of a multi-currency wallet or a fee summary.
+// The zero value is empty and ready to use. It's encoded in JSON as an object of fixed amounts by currency
+// code in ascending order, e.g. {"EUR":"12.34","USD":"-0.50"}.
+type Amounts struct {
+	totals map[string]*Money
+}
+
+// Add adds m to the total of its currency.
+func (a *Amounts) Add(m *Money) error {
+	return a.apply(m, (*Money).AddAll)
+}
+
+// Subtract subtracts m from the total of its currency.
+func (a *Amounts) Subtract(m *Money) error {
+	return a.apply(m, (*Money).SubtractAll)
+}
+
+func (a *Amounts) apply(m *Money, op func(*Money, ...*Money) (*Money, error)) error {
+	code := m.CurrencyCode()
+	if code == "" {
+		return fmt.Errorf("invalid currency '%s'", code)
+	}
+
+	t, ok := a.totals[code]
+	if !ok {
+		t = m.WithAmount(0)
+	}
+
+	r, err := op(t, m)
+	if err != nil {
+		return err
+	}
+
+	if a.totals == nil {
+		a.totals = map[string]*Money{}
+	}
+	a.totals[code] = r
+
+	return nil
+}
+
+// Get returns the total of the given currency, or nil if nothing was added in it.
+func (a *Amounts) Get(code string) *Money {
+	return a.totals[code]
+}
+
+// Codes returns the currency codes with a total in ascending order.
+func (a *Amounts) Codes() []string {
+	codes := make([]string, 0, len(a.totals))
+	for code := range a.totals {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	return codes
+}
+
+// Len returns the number of currencies with a total.
+func (a *Amounts) Len() int {
+	return len(a.totals)
+}
+
+// MarshalJSON is implementation of json.Marshaller
+func (a Amounts) MarshalJSON() ([]byte, error) {
+	o := make(map[string]string, len(a.totals))
+	for code, t := range a.totals {
+		o[code] = t.AmountFixed()
+	}
+
+	return json.Marshal(o)
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller
+func (a *Amounts) UnmarshalJSON(b []byte) error {
+	var o map[string]jsonString
+	if err := json.Unmarshal(b, &o); err != nil {
+		return err
+	}
+
+	totals := make(map[string]*Money, len(o))
+	for code, amount := range o {
+		m, err := parseSignedAmount(string(amount), code, ".", "")
+		if err != nil {
+			return err
+		}
+		totals[code] = m
+	}
+
+	a.totals = totals
+	return nil
+}
+
+// ConvertedTotal is the total of one currency converted by TotalIn, with the exchange rate applied.
+type ConvertedTotal struct {
+	Total     *Money
+	Rate      Rate
+	Converted *Money
+}
+
+// Consolidation is the result of TotalIn: the totals of all currencies converted into one,
+// along with the details of every conversion in ascending order of currency code.
+type Consolidation struct {
+	Total *Money
+	Lines []ConvertedTotal
+}
+
+// TotalIn converts the total of every currency into the given one using the converter and sums them up,
+// e.g. for consolidated reporting. Each total is converted and rounded on its own, so that the result
+// is the sum of its lines.
+func (a *Amounts) TotalIn(currencyCode string, c Converter) (*Consolidation, error) {
+	sum, err := New(0, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Consolidation{Total: sum, Lines: make([]ConvertedTotal, 0, len(a.totals))}
+	for _, code := range a.Codes() {
+		t := a.totals[code]
+
+		converted, rate, err := t.Convert(currencyCode, c)
+		if err != nil {
+			return nil, err
+		}
+
+		if r.Total, err = r.Total.AddAll(converted); err != nil {
+			return nil, err
+		}
+
+		r.Lines = append(r.Lines, ConvertedTotal{Total: t, Rate: rate, Converted: converted})
+	}
+
+	return r, nil
+}
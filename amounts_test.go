@@ -0,0 +1,142 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAmounts(t *testing.T) {
+	var a Amounts
+
+	ops := []struct {
+		op     func(*Money) error
+		amount int64
+		code   string
+	}{
+		{a.Add, 1000, EUR},
+		{a.Add, 250, USD},
+		{a.Subtract, 300, EUR},
+		{a.Add, 500, JPY},
+		{a.Subtract, 300, USD},
+	}
+
+	for _, o := range ops {
+		m, _ := New(o.amount, o.code)
+		if err := o.op(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := map[string]int64{EUR: 700, USD: -50, JPY: 500}
+	for code, e := range expected {
+		if r := a.Get(code); r == nil || r.AmountUnformatted() != e || r.CurrencyCode() != code {
+			t.Errorf("Expected %s total %d got %v", code, e, r)
+		}
+	}
+
+	if a.Get(GBP) != nil || a.Len() != 3 || !reflect.DeepEqual(a.Codes(), []string{EUR, JPY, USD}) {
+		t.Errorf("Expected totals in %v only got %v", []string{EUR, JPY, USD}, a.Codes())
+	}
+
+	b, err := json.Marshal(a)
+	if err != nil || string(b) != `{"EUR":"7.00","JPY":"500","USD":"-0.50"}` {
+		t.Errorf("Expected %s got %s, %v", `{"EUR":"7.00","JPY":"500","USD":"-0.50"}`, b, err)
+	}
+
+	var u Amounts
+	if err := json.Unmarshal(b, &u); err != nil || !reflect.DeepEqual(u, a) {
+		t.Errorf("Expected %s to unmarshal to %v got %v, %v", b, a, u, err)
+	}
+}
+
+func TestAmounts_Errors(t *testing.T) {
+	var a Amounts
+
+	max, _ := New(math.MaxInt64, EUR)
+	one, _ := New(1, EUR)
+	_ = a.Add(max)
+	if err := a.Add(one); err != ErrOverflow {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	micros, _ := NewFromMicros(1, EUR)
+	if err := a.Add(micros); err != ErrCurrencyMismatch {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if err := a.Add(&Money{}); err == nil {
+		t.Error("Expected error for zero value Money")
+	}
+
+	if a.Get(EUR).AmountUnformatted() != math.MaxInt64 {
+		t.Errorf("Expected failed operations to keep %d got %d", int64(math.MaxInt64), a.Get(EUR).AmountUnformatted())
+	}
+
+	for _, in := range []string{`{"XXX":"1.00"}`, `{"EUR":"1.001"}`, `{"EUR":1}`, `{"EUR":"1,00"}`, `[]`} {
+		var u Amounts
+		if err := json.Unmarshal([]byte(in), &u); err == nil {
+			t.Errorf("Expected error for %s", in)
+		}
+	}
+}
+
+func TestAmounts_TotalIn(t *testing.T) {
+	var a Amounts
+	for _, m := range []struct {
+		amount int64
+		code   string
+	}{{1000, EUR}, {-250, USD}, {15000, JPY}, {500, EUR}} {
+		n, _ := New(m.amount, m.code)
+		_ = a.Add(n)
+	}
+
+	rates := RateTable{}
+	rates.Set(USD, EUR, Rate{92, 100})
+	rates.Set(EUR, JPY, Rate{160, 1})
+
+	r, err := a.TotalIn(EUR, rates)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := []struct {
+		code      string
+		rate      Rate
+		converted int64
+	}{
+		{EUR, Rate{1, 1}, 1500},
+		{JPY, Rate{1, 160}, 9375},
+		{USD, Rate{92, 100}, -230},
+	}
+
+	if len(r.Lines) != len(lines) {
+		t.Fatalf("Expected %d lines got %d", len(lines), len(r.Lines))
+	}
+
+	for i, l := range lines {
+		got := r.Lines[i]
+		if got.Total.CurrencyCode() != l.code || got.Rate != l.rate || got.Converted.AmountUnformatted() != l.converted || got.Converted.CurrencyCode() != EUR {
+			t.Errorf("Expected line %d to convert %s at %v into %d got %v at %v into %v", i, l.code, l.rate, l.converted, got.Total, got.Rate, got.Converted)
+		}
+	}
+
+	if r.Total.AmountUnformatted() != 10645 || r.Total.CurrencyCode() != EUR {
+		t.Errorf("Expected total %d got %v", 10645, r.Total)
+	}
+
+	var empty Amounts
+	if r, err := empty.TotalIn(USD, rates); err != nil || !r.Total.IsZero() || len(r.Lines) != 0 {
+		t.Errorf("Expected empty consolidation got %v, %v", r, err)
+	}
+
+	if _, err := a.TotalIn(GBP, rates); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected %v got %v", ErrNoRate, err)
+	}
+
+	if _, err := a.TotalIn("XXX", rates); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+}
@@ -0,0 +1,69 @@
+package money
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// AvroSchema is the Avro schema of Money as encoded by MarshalAvro, a record of the amount in the currency's
+// smallest unit and the ISO 4217 currency code. Register it with a schema registry to validate topics carrying Money.
+const AvroSchema = `{
+  "type": "record",
+  "name": "Money",
+  "namespace": "money",
+  "fields": [
+    {"name": "amount", "type": "long", "doc": "amount in the smallest unit of the currency"},
+    {"name": "currency", "type": "string", "doc": "ISO 4217 currency code"}
+  ]
+}`
+
+// MarshalAvro returns Money encoded in the Avro binary encoding of AvroSchema.
+// Zero value Money is encoded with an empty currency.
+func (m *Money) MarshalAvro() ([]byte, error) {
+	code := m.CurrencyCode()
+	if m.currency != nil {
+		if err := m.currency.get().assertSmallestUnit(); err != nil {
+			return nil, err
+		}
+	}
+
+	b := make([]byte, 2*binary.MaxVarintLen64+len(code))
+	n := binary.PutVarint(b, m.amount)
+	n += binary.PutVarint(b[n:], int64(len(code)))
+	n += copy(b[n:], code)
+	return b[:n], nil
+}
+
+// UnmarshalAvro parses Money from the Avro binary encoding of AvroSchema.
+// An empty currency is read as zero value Money.
+func (m *Money) UnmarshalAvro(b []byte) error {
+	amount, n := binary.Varint(b)
+	if n <= 0 {
+		return errors.New("invalid avro money amount")
+	}
+	b = b[n:]
+
+	l, n := binary.Varint(b)
+	if n <= 0 || l < 0 || int64(len(b)-n) != l {
+		return errors.New("invalid avro money currency")
+	}
+	code := string(b[n:])
+
+	if code == "" {
+		if amount != 0 {
+			return errors.New("invalid avro money currency")
+		}
+
+		*m = Money{}
+		return nil
+	}
+
+	currency := GetCurrency(code)
+	if currency == nil {
+		return fmt.Errorf("invalid currency '%s'", code)
+	}
+
+	*m = Money{amount: amount, currency: currency}
+	return nil
+}
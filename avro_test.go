@@ -0,0 +1,90 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestMoney_MarshalAvro(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected []byte
+	}{
+		{1234, EUR, []byte{0xa4, 0x13, 0x06, 'E', 'U', 'R'}},
+		{-1, USD, []byte{0x01, 0x06, 'U', 'S', 'D'}},
+		{0, JPY, []byte{0x00, 0x06, 'J', 'P', 'Y'}},
+		{math.MaxInt64, EUR, []byte{0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x06, 'E', 'U', 'R'}},
+		{math.MinInt64, EUR, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x06, 'E', 'U', 'R'}},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		b, err := m.MarshalAvro()
+		if err != nil || !bytes.Equal(b, tc.expected) {
+			t.Errorf("Expected %d %s to encode as %x got %x, %v", tc.amount, tc.code, tc.expected, b, err)
+		}
+
+		var u Money
+		if err := u.UnmarshalAvro(b); err != nil || u != *m {
+			t.Errorf("Expected %x to decode as %v got %v, %v", b, m, u, err)
+		}
+	}
+
+	var zero Money
+	b, err := zero.MarshalAvro()
+	if err != nil || !bytes.Equal(b, []byte{0x00, 0x00}) {
+		t.Errorf("Expected zero value to encode as %x got %x, %v", []byte{0x00, 0x00}, b, err)
+	}
+
+	m, _ := New(1, EUR)
+	if err := m.UnmarshalAvro(b); err != nil || *m != zero {
+		t.Errorf("Expected zero value got %v, %v", m, err)
+	}
+
+	micros, _ := NewFromMicros(1, EUR)
+	if _, err := micros.MarshalAvro(); err == nil {
+		t.Error("Expected error for amount in micros")
+	}
+}
+
+func TestMoney_UnmarshalAvro_Errors(t *testing.T) {
+	tcs := [][]byte{
+		{},
+		{0xa4},
+		{0xa4, 0x13},
+		{0xa4, 0x13, 0x06, 'E', 'U'},
+		{0xa4, 0x13, 0x06, 'E', 'U', 'R', 'O'},
+		{0xa4, 0x13, 0x05, 'E', 'U', 'R'},
+		{0xa4, 0x13, 0x06, 'X', 'X', 'X'},
+		{0xa4, 0x13, 0x00},
+	}
+
+	for _, b := range tcs {
+		var m Money
+		if err := m.UnmarshalAvro(b); err == nil {
+			t.Errorf("Expected error for %x", b)
+		}
+	}
+}
+
+func TestAvroSchema(t *testing.T) {
+	var schema struct {
+		Type   string
+		Name   string
+		Fields []struct {
+			Name string
+			Type string
+		}
+	}
+
+	if err := json.Unmarshal([]byte(AvroSchema), &schema); err != nil {
+		t.Fatal(err)
+	}
+
+	if schema.Type != "record" || len(schema.Fields) != 2 || schema.Fields[0].Type != "long" || schema.Fields[1].Type != "string" {
+		t.Errorf("Unexpected schema %+v", schema)
+	}
+}
@@ -0,0 +1,72 @@
+package money
+
+import "testing"
+
+func BenchmarkNew(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = New(int64(i), EUR)
+	}
+}
+
+func BenchmarkMoney_Add(b *testing.B) {
+	m, _ := New(100, EUR)
+	om, _ := New(200, EUR)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Add(om)
+	}
+}
+
+func BenchmarkMoney_Compare(b *testing.B) {
+	m, _ := New(100, EUR)
+	om, _ := New(200, EUR)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Compare(om)
+	}
+}
+
+func BenchmarkMoney_Display(b *testing.B) {
+	m, _ := New(123456789, EUR)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.Display()
+	}
+}
+
+func BenchmarkMoney_Allocate(b *testing.B) {
+	m, _ := New(1<<62, EUR)
+	rs := make([]int, 1000)
+	for i := range rs {
+		rs[i] = i + 1
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Allocate(rs...)
+	}
+}
+
+func BenchmarkMoney_Amount(b *testing.B) {
+	m, _ := New(123456789, EUR)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.Amount()
+	}
+}
+
+func BenchmarkMoney_UnmarshalJSON(b *testing.B) {
+	data := []byte(`{"amount": "1234.56", "currency": "EUR"}`)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var m Money
+		_ = m.UnmarshalJSON(data)
+	}
+}
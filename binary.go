@@ -0,0 +1,71 @@
+package money
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// BinarySize is the length in bytes of Money in the fixed-width binary encoding of AppendBinary.
+const BinarySize = 11
+
+// AppendBinary appends Money to b in a fixed-width binary encoding of BinarySize bytes, for fixed-length
+// records and log segments:
+//
+//	bytes 0-7   the amount in the currency's smallest unit, a big-endian two's complement int64
+//	bytes 8-10  the ISO 4217 currency code in ASCII
+//
+// Zero value Money is encoded with a currency code of three zero bytes. Currencies whose code isn't
+// three ASCII characters can't be encoded.
+func (m *Money) AppendBinary(b []byte) ([]byte, error) {
+	var code [3]byte
+	if m.currency != nil {
+		c := m.currency.get()
+		if err := c.assertSmallestUnit(); err != nil {
+			return b, err
+		}
+
+		if len(c.Code) != len(code) || c.Code[0] == 0 {
+			return b, fmt.Errorf("currency '%s' can't be encoded in binary", c.Code)
+		}
+
+		for i := range code {
+			if c.Code[i] > 0x7f {
+				return b, fmt.Errorf("currency '%s' can't be encoded in binary", c.Code)
+			}
+			code[i] = c.Code[i]
+		}
+	}
+
+	var buf [BinarySize]byte
+	binary.BigEndian.PutUint64(buf[:8], uint64(m.amount))
+	copy(buf[8:], code[:])
+
+	return append(b, buf[:]...), nil
+}
+
+// ParseBinary parses Money from exactly BinarySize bytes written by AppendBinary.
+// A currency code of three zero bytes is read as zero value Money.
+func ParseBinary(b []byte) (*Money, error) {
+	if len(b) != BinarySize {
+		return nil, fmt.Errorf("binary money must be %d bytes long, got %d", BinarySize, len(b))
+	}
+
+	amount := int64(binary.BigEndian.Uint64(b[:8]))
+	code := string(b[8:])
+
+	if code == "\x00\x00\x00" {
+		if amount != 0 {
+			return nil, errors.New("invalid binary money currency")
+		}
+
+		return &Money{}, nil
+	}
+
+	currency := GetCurrency(code)
+	if currency == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", code)
+	}
+
+	return &Money{amount: amount, currency: currency}, nil
+}
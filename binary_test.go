@@ -0,0 +1,69 @@
+package money
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestMoney_AppendBinary(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected []byte
+	}{
+		{1234, EUR, []byte{0, 0, 0, 0, 0, 0, 0x04, 0xd2, 'E', 'U', 'R'}},
+		{-1, USD, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 'U', 'S', 'D'}},
+		{math.MaxInt64, JPY, []byte{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 'J', 'P', 'Y'}},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		b, err := m.AppendBinary([]byte("id"))
+		if err != nil || !bytes.Equal(b[2:], tc.expected) || string(b[:2]) != "id" {
+			t.Errorf("Expected %x got %x, %v", tc.expected, b, err)
+			continue
+		}
+
+		r, err := ParseBinary(b[2:])
+		if err != nil || *r != *m {
+			t.Errorf("Expected %v got %v, %v", m, r, err)
+		}
+	}
+
+	zero := &Money{}
+	b, err := zero.AppendBinary(nil)
+	if err != nil || !bytes.Equal(b, make([]byte, BinarySize)) {
+		t.Errorf("Expected zero bytes got %x, %v", b, err)
+	}
+
+	if r, err := ParseBinary(b); err != nil || *r != *zero {
+		t.Errorf("Expected zero value got %v, %v", r, err)
+	}
+}
+
+func TestMoney_AppendBinary_Errors(t *testing.T) {
+	m, _ := New(1, EUR)
+	micros, _ := m.ToMicros()
+	if b, err := micros.AppendBinary([]byte("x")); err == nil || string(b) != "x" {
+		t.Errorf("Expected error leaving the buffer untouched got %x, %v", b, err)
+	}
+
+	defer Restore(Snapshot())
+	AddCurrency("GOLD", "g", "1 $", ".", "", 3)
+	gold, _ := New(1, "GOLD")
+	if _, err := gold.AppendBinary(nil); err == nil {
+		t.Error("Expected error for currency code of 4 characters")
+	}
+
+	for _, b := range [][]byte{
+		nil,
+		{0, 0, 0, 0, 0, 0, 0, 1, 'E', 'U', 'R', 0},
+		{0, 0, 0, 0, 0, 0, 0, 1, 'X', 'X', 'X'},
+		{0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0},
+	} {
+		if m, err := ParseBinary(b); err == nil {
+			t.Errorf("Expected error for %x got %v", b, m)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrMissingField happens when a form or query doesn't contain a requested Money field.
+var ErrMissingField = errors.New("missing field")
+
+// BindError describes why Money couldn't be bound from a form field or query parameter.
+// It always means the client sent invalid data, so handlers can answer it with 400 Bad Request.
+type BindError struct {
+	// Field is the name of the offending field.
+	Field string
+	// Value is the value sent for the field.
+	Value string
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *BindError) Error() string {
+	return fmt.Sprintf("invalid money field '%s': %v", e.Field, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *BindError) Unwrap() error {
+	return e.Err
+}
+
+// ParseForm reads Money from form values or query parameters, either split into an amount and a
+// currency field like amount=12.34&currency=EUR, or from the amount field alone like amount=EUR%2012.34.
+// An empty currencyField always reads the latter. Any failure is reported as *BindError.
+func ParseForm(values url.Values, amountField, currencyField string) (*Money, error) {
+	amount := strings.TrimSpace(values.Get(amountField))
+	if amount == "" {
+		return nil, &BindError{Field: amountField, Err: ErrMissingField}
+	}
+
+	var currency string
+	if currencyField != "" {
+		currency = strings.TrimSpace(values.Get(currencyField))
+	}
+
+	if currency == "" {
+		m, err := parseCodeAmount(amount)
+		if err != nil {
+			return nil, &BindError{Field: amountField, Value: amount, Err: err}
+		}
+
+		return m, nil
+	}
+
+	if GetCurrency(currency) == nil {
+		return nil, &BindError{Field: currencyField, Value: currency, Err: fmt.Errorf("invalid currency '%s'", currency)}
+	}
+
+	m, err := parseAmount(amount, currency)
+	if err != nil {
+		return nil, &BindError{Field: amountField, Value: amount, Err: err}
+	}
+
+	return m, nil
+}
+
+// FromRequest reads Money from the request's form or query, see ParseForm.
+func FromRequest(r *http.Request, amountField, currencyField string) (*Money, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, &BindError{Field: amountField, Err: err}
+	}
+
+	return ParseForm(r.Form, amountField, currencyField)
+}
+
+// UnmarshalText is implementation of encoding.TextUnmarshaler, parsing Money written like "EUR 12.34".
+func (m *Money) UnmarshalText(text []byte) error {
+	r, err := parseCodeAmount(string(text))
+	if err != nil {
+		return err
+	}
+
+	*m = *r
+	return nil
+}
+
+// UnmarshalParam parses Money written like "EUR 12.34", as expected by the form and query binders of echo and gin.
+func (m *Money) UnmarshalParam(param string) error {
+	return m.UnmarshalText([]byte(param))
+}
+
+// parseCodeAmount parses Money written as a currency code and an amount separated by a space, like "EUR 12.34".
+func parseCodeAmount(s string) (*Money, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return nil, fmt.Errorf("invalid money '%s', expected currency code and amount", s)
+	}
+
+	if GetCurrency(fields[0]) == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", fields[0])
+	}
+
+	return parseAmount(fields[1], fields[0])
+}
+
+// parseAmount works like NewFromString, rejecting amounts with more decimal places than the currency has
+// instead of truncating them.
+func parseAmount(amount string, currencyCode string) (*Money, error) {
+	currency := GetCurrency(currencyCode)
+	if i := strings.Index(amount, currency.Decimal); i != -1 && len(amount)-i-1 > currency.Fraction {
+		return nil, fmt.Errorf("amount '%s' has more than %d decimal places", amount, currency.Fraction)
+	}
+
+	return NewFromString(amount, currencyCode)
+}
@@ -0,0 +1,108 @@
+package money
+
+import (
+	"errors"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestParseForm(t *testing.T) {
+	tcs := []struct {
+		query    string
+		currency string
+		expected string
+	}{
+		{"amount=12.34&currency=EUR", "currency", "€12.34"},
+		{"amount=12.3&currency=EUR", "currency", "€12.30"},
+		{"amount=-5&currency=USD", "currency", "-$5.00"},
+		{"amount=EUR%2012.34", "currency", "€12.34"},
+		{"amount=EUR+12.34", "", "€12.34"},
+		{"amount=+JPY+1000+", "", "¥1000"},
+	}
+
+	for _, tc := range tcs {
+		values, _ := url.ParseQuery(tc.query)
+		m, err := ParseForm(values, "amount", tc.currency)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s: %v", tc.query, err)
+		}
+
+		if m.Display() != tc.expected {
+			t.Errorf("Expected %s to be %s got %s", tc.query, tc.expected, m.Display())
+		}
+	}
+}
+
+func TestParseForm_Errors(t *testing.T) {
+	tcs := []struct {
+		query string
+		field string
+	}{
+		{"currency=EUR", "amount"},
+		{"amount=&currency=EUR", "amount"},
+		{"amount=12.34&currency=XXX", "currency"},
+		{"amount=12.345&currency=EUR", "amount"},
+		{"amount=abc&currency=EUR", "amount"},
+		{"amount=12.34", "amount"},
+		{"amount=XXX+12.34", "amount"},
+		{"amount=EUR+12.34+EUR", "amount"},
+	}
+
+	for _, tc := range tcs {
+		values, _ := url.ParseQuery(tc.query)
+		_, err := ParseForm(values, "amount", "currency")
+
+		var be *BindError
+		if !errors.As(err, &be) {
+			t.Fatalf("Expected BindError for %s got %v", tc.query, err)
+		}
+
+		if be.Field != tc.field {
+			t.Errorf("Expected error for %s on field %s got %s", tc.query, tc.field, be.Field)
+		}
+	}
+
+	values, _ := url.ParseQuery("currency=EUR")
+	if _, err := ParseForm(values, "amount", "currency"); !errors.Is(err, ErrMissingField) {
+		t.Errorf("Expected %v got %v", ErrMissingField, err)
+	}
+}
+
+func TestFromRequest(t *testing.T) {
+	r := httptest.NewRequest("POST", "/pay?currency=GBP", strings.NewReader("price=9.99"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	m, err := FromRequest(r, "price", "currency")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Display() != "£9.99" {
+		t.Errorf("Expected %s got %s", "£9.99", m.Display())
+	}
+}
+
+func TestMoney_UnmarshalText(t *testing.T) {
+	var m Money
+	if err := m.UnmarshalText([]byte("EUR 12.34")); err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Display() != "€12.34" {
+		t.Errorf("Expected %s got %s", "€12.34", m.Display())
+	}
+
+	if err := m.UnmarshalParam("USD 1"); err != nil || m.Display() != "$1.00" {
+		t.Errorf("Expected %s got %s", "$1.00", m.Display())
+	}
+
+	if err := m.UnmarshalParam("12.34"); err == nil {
+		t.Error("Expected error for missing currency")
+	}
+
+	if m.Display() != "$1.00" {
+		t.Errorf("Expected failed unmarshal to leave %s got %s", "$1.00", m.Display())
+	}
+}
@@ -0,0 +1,184 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Envelope is a named part of a Budget which gets funded up to its target.
+type Envelope struct {
+	Name   string
+	Target *Money
+	// Priority orders the envelopes, lower priorities being funded first.
+	Priority int
+	// Weight is the ratio in which envelopes of the same priority share money which can't fund all of them.
+	Weight int
+	// Funded is the amount allocated to the envelope so far.
+	Funded *Money
+}
+
+// Unfunded returns the amount still missing to reach the target of the envelope, zero once it's reached.
+func (e *Envelope) Unfunded() *Money {
+	a := mutate.calc.subtract(e.Target.amount, e.Funded.amount)
+	if a < 0 {
+		a = 0
+	}
+
+	return &Money{amount: a, currency: e.Target.currency}
+}
+
+// BudgetAllocation is the outcome of allocating money to a Budget.
+type BudgetAllocation struct {
+	// Envelopes holds the amount allocated to every envelope, in the order the envelopes were added.
+	Envelopes []*Money
+	// Unallocated is the amount left once all envelopes reached their target.
+	Unallocated *Money
+}
+
+// Budget splits incoming money across envelopes by priority and weight, like the envelope budgeting
+// of personal-finance apps: rent and groceries get funded before the holiday savings.
+type Budget struct {
+	currency  *Currency
+	envelopes []*Envelope
+}
+
+// NewBudget creates and returns new empty Budget in the given currency.
+func NewBudget(currencyCode string) (*Budget, error) {
+	currency := GetCurrency(currencyCode)
+	if currency == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", currencyCode)
+	}
+
+	return &Budget{currency: currency}, nil
+}
+
+// AddEnvelope adds an envelope with the given target, priority and weight.
+// Names must be unique and weights of zero count as one.
+func (b *Budget) AddEnvelope(name string, target *Money, priority, weight int) error {
+	if b.Envelope(name) != nil {
+		return fmt.Errorf("envelope '%s' already exists", name)
+	}
+
+	zero := &Money{currency: b.currency}
+	if err := zero.assertSameCurrency(target); err != nil {
+		return err
+	}
+
+	if target.IsNegative() {
+		return errors.New("envelope target must not be negative")
+	}
+
+	if weight < 0 {
+		return errors.New("envelope weight must not be negative")
+	}
+
+	if weight == 0 {
+		weight = 1
+	}
+
+	b.envelopes = append(b.envelopes, &Envelope{Name: name, Target: target, Priority: priority, Weight: weight, Funded: zero})
+	return nil
+}
+
+// Envelope returns the envelope with the given name, nil if there is none.
+func (b *Budget) Envelope(name string) *Envelope {
+	for _, e := range b.envelopes {
+		if e.Name == name {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// Envelopes returns the envelopes of the budget in the order they were added.
+func (b *Budget) Envelopes() []*Envelope {
+	return b.envelopes
+}
+
+// Unfunded returns the total still missing to reach the targets of all envelopes.
+func (b *Budget) Unfunded() *Money {
+	total := &Money{currency: b.currency}
+	for _, e := range b.envelopes {
+		total.amount = mutate.calc.add(total.amount, e.Unfunded().amount)
+	}
+
+	return total
+}
+
+// Allocate funds the envelopes with income. Envelopes are funded by ascending priority, all envelopes of
+// a priority being funded before the next one gets anything. When income can't fund all envelopes of a priority,
+// it's allocated by their weights, and what an envelope doesn't need goes to the others of the same priority.
+// Income left once all targets are reached is returned as unallocated.
+func (b *Budget) Allocate(income *Money) (*BudgetAllocation, error) {
+	zero := &Money{currency: b.currency}
+	if err := zero.assertSameCurrency(income); err != nil {
+		return nil, err
+	}
+
+	if income.IsNegative() {
+		return nil, errors.New("can't allocate negative amount")
+	}
+
+	order := make([]int, len(b.envelopes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return b.envelopes[order[i]].Priority < b.envelopes[order[j]].Priority
+	})
+
+	as := make([]Amount, len(b.envelopes))
+	rest := income.amount
+	for start := 0; start < len(order) && rest > 0; {
+		end := start
+		for end < len(order) && b.envelopes[order[end]].Priority == b.envelopes[order[start]].Priority {
+			end++
+		}
+
+		rest = b.fund(order[start:end], as, rest)
+		start = end
+	}
+
+	res := &BudgetAllocation{Envelopes: make([]*Money, len(b.envelopes)), Unallocated: &Money{amount: rest, currency: b.currency}}
+	for i, e := range b.envelopes {
+		e.Funded = &Money{amount: mutate.calc.add(e.Funded.amount, as[i]), currency: b.currency}
+		res.Envelopes[i] = &Money{amount: as[i], currency: b.currency}
+	}
+
+	return res, nil
+}
+
+// fund allocates rest to the envelopes of one priority by weight, adding to as, and returns what's left.
+// Shares above what an envelope needs are allocated again to the envelopes still needing money.
+func (b *Budget) fund(level []int, as []Amount, rest Amount) Amount {
+	for rest > 0 {
+		var open []int
+		var weights []int
+		for _, i := range level {
+			e := b.envelopes[i]
+			if mutate.calc.subtract(e.Unfunded().amount, as[i]) > 0 {
+				open = append(open, i)
+				weights = append(weights, e.Weight)
+			}
+		}
+
+		if len(open) == 0 {
+			break
+		}
+
+		shares, _ := (&Money{amount: rest, currency: b.currency}).Allocate(weights...)
+		for k, i := range open {
+			a := shares[k].amount
+			if need := mutate.calc.subtract(b.envelopes[i].Unfunded().amount, as[i]); a > need {
+				a = need
+			}
+
+			as[i] = mutate.calc.add(as[i], a)
+			rest = mutate.calc.subtract(rest, a)
+		}
+	}
+
+	return rest
+}
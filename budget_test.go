@@ -0,0 +1,114 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBudget_Allocate(t *testing.T) {
+	b, _ := NewBudget(EUR)
+	envelopes := []struct {
+		name     string
+		target   int64
+		priority int
+		weight   int
+	}{
+		{"rent", 80000, 0, 1},
+		{"groceries", 30000, 1, 2},
+		{"transport", 5000, 1, 1},
+		{"holiday", 50000, 2, 0},
+	}
+
+	for _, e := range envelopes {
+		target, _ := New(e.target, EUR)
+		if err := b.AddEnvelope(e.name, target, e.priority, e.weight); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tcs := []struct {
+		income      int64
+		expected    []int64
+		unallocated int64
+		unfunded    int64
+	}{
+		// Rent first, then groceries and transport share 2:1, transport getting its full 50.00
+		// and groceries what transport didn't need.
+		{100000, []int64{80000, 15000, 5000, 0}, 0, 65000},
+		{10000, []int64{0, 10000, 0, 0}, 0, 55000},
+		{100000, []int64{0, 5000, 0, 50000}, 45000, 0},
+		{5000, []int64{0, 0, 0, 0}, 5000, 0},
+	}
+
+	for _, tc := range tcs {
+		income, _ := New(tc.income, EUR)
+		r, err := b.Allocate(income)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, a := range r.Envelopes {
+			if a.amount != tc.expected[i] {
+				t.Errorf("Expected %s to get %d of %d got %d", envelopes[i].name, tc.expected[i], tc.income, a.amount)
+			}
+		}
+
+		if r.Unallocated.amount != tc.unallocated || b.Unfunded().amount != tc.unfunded {
+			t.Errorf("Expected %d unallocated and %d unfunded got %d and %d", tc.unallocated, tc.unfunded, r.Unallocated.amount, b.Unfunded().amount)
+		}
+	}
+
+	if e := b.Envelope("groceries"); e.Funded.Display() != "€300.00" || !e.Unfunded().IsZero() {
+		t.Errorf("Expected groceries to be funded got %s", e.Funded.Display())
+	}
+}
+
+func TestBudget_Allocate_Weights(t *testing.T) {
+	b, _ := NewBudget(EUR)
+	for _, name := range []string{"a", "b", "c"} {
+		target, _ := New(1000, EUR)
+		_ = b.AddEnvelope(name, target, 0, 1)
+	}
+
+	income, _ := New(100, EUR)
+	r, _ := b.Allocate(income)
+	if r.Envelopes[0].amount != 34 || r.Envelopes[1].amount != 33 || r.Envelopes[2].amount != 33 {
+		t.Errorf("Expected 34, 33 and 33 got %v", r.Envelopes)
+	}
+}
+
+func TestBudget_Errors(t *testing.T) {
+	if _, err := NewBudget("XXX"); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+
+	b, _ := NewBudget(EUR)
+	target, _ := New(100, EUR)
+	negative, _ := New(-100, EUR)
+	usd, _ := New(100, USD)
+	_ = b.AddEnvelope("rent", target, 0, 1)
+
+	if err := b.AddEnvelope("rent", target, 0, 1); err == nil {
+		t.Error("Expected error for duplicate envelope")
+	}
+
+	if err := b.AddEnvelope("x", usd, 0, 1); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if err := b.AddEnvelope("x", negative, 0, 1); err == nil {
+		t.Error("Expected error for negative target")
+	}
+
+	if err := b.AddEnvelope("x", target, 0, -1); err == nil {
+		t.Error("Expected error for negative weight")
+	}
+
+	if _, err := b.Allocate(usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := b.Allocate(negative); err == nil {
+		t.Error("Expected error for negative income")
+	}
+}
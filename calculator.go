@@ -1,6 +1,10 @@
 package money
 
-import "math"
+import (
+	"errors"
+	"math"
+	"math/big"
+)
 
 type calculator struct{}
 
@@ -24,12 +28,43 @@ func (c *calculator) modulus(a Amount, d int64) Amount {
 	return a % d
 }
 
-func (c *calculator) allocate(a Amount, r, s uint) Amount {
-	if a == 0 || s == 0 {
+// allocate returns a*r/s truncated towards zero, s being the sum of all ratios. The product is computed
+// on 128 bits, so that it can't overflow and the shares of all ratios add up to a minus less than one unit
+// per ratio. Sums beyond an int64 are divided with math/big.
+func (c *calculator) allocate(a Amount, r int64, s Int128) Amount {
+	if a == 0 || s.Sign() == 0 {
 		return 0
 	}
 
-	return a * int64(r) / int64(s)
+	p := NewInt128(a).Mul64(r)
+	if d, ok := s.Int64(); ok {
+		q, _ := p.QuoRem64(d)
+		v, _ := q.Int64()
+		return v
+	}
+
+	return new(big.Int).Quo(p.bigInt(), s.bigInt()).Int64()
+}
+
+// spread adds lo to as, giving every part lo/len(as) and one more unit to as many parts as the
+// remainder from the first one on, in constant time per part. It returns the number of parts
+// which received one more unit.
+func (c *calculator) spread(as []Amount, lo Amount) int {
+	n := int64(len(as))
+	q, r := c.divide(lo, n), c.modulus(lo, n)
+	v := int64(1)
+	if r < 0 {
+		v = -1
+	}
+
+	for p := range as {
+		as[p] = c.add(as[p], q)
+		if int64(p) < r*v {
+			as[p] = c.add(as[p], v)
+		}
+	}
+
+	return int(r * v)
 }
 
 func (c *calculator) absolute(a Amount) Amount {
@@ -71,3 +106,130 @@ func (c *calculator) round(a Amount, e int) Amount {
 
 	return a
 }
+
+// addChecked returns a+b or ErrOverflow if the sum doesn't fit into an Amount.
+func (c *calculator) addChecked(a, b Amount) (Amount, error) {
+	s := a + b
+	if (b > 0 && s < a) || (b < 0 && s > a) {
+		return 0, ErrOverflow
+	}
+
+	return s, nil
+}
+
+// subtractChecked returns a-b or ErrOverflow if the difference doesn't fit into an Amount.
+func (c *calculator) subtractChecked(a, b Amount) (Amount, error) {
+	s := a - b
+	if (b > 0 && s > a) || (b < 0 && s < a) {
+		return 0, ErrOverflow
+	}
+
+	return s, nil
+}
+
+// multiplyRatio returns a*n/d rounded half away from zero, or ErrOverflow if it doesn't fit into an Amount.
+func (c *calculator) multiplyRatio(a Amount, n, d int64) (Amount, error) {
+	return c.mulDivRound(a, n, d, RoundHalfUp)
+}
+
+// divideRound returns a/d rounded using the given mode.
+func (c *calculator) divideRound(a Amount, d int64, mode RoundingMode) Amount {
+	return c.roundQuotient(a/d, a%d, d, (a < 0) != (d < 0), mode)
+}
+
+// mulDivRound returns a*n/d rounded using the given mode. The product is computed on 128 bits,
+// so that only a result not fitting into an Amount overflows.
+func (c *calculator) mulDivRound(a Amount, n, d int64, mode RoundingMode) (Amount, error) {
+	p := NewInt128(a).Mul64(n)
+	q, r := p.QuoRem64(d)
+
+	v, ok := q.Int64()
+	if !ok {
+		return 0, ErrOverflow
+	}
+
+	return c.roundQuotient(v, r, d, (p.Sign() < 0) != (d < 0), mode), nil
+}
+
+// roundQuotient rounds q, the quotient of a division by d truncated towards zero leaving remainder r.
+// negative tells whether the exact quotient is below zero.
+func (c *calculator) roundQuotient(q Amount, r, d int64, negative bool, mode RoundingMode) Amount {
+	if r == 0 {
+		return q
+	}
+
+	// Comparing the remainder with what's left of the divisor can't overflow, unlike doubling it.
+	half := 0
+	switch mr, md := magnitude(r), magnitude(d); {
+	case mr > md-mr:
+		half = 1
+	case mr < md-mr:
+		half = -1
+	}
+
+	if !mode.away(negative, half, q%2 != 0) {
+		return q
+	}
+
+	if negative {
+		return q - 1
+	}
+
+	return q + 1
+}
+
+// distribute divides a into n parts, spreading the remainder according to the given strategy.
+func (c *calculator) distribute(a Amount, n int, s RemainderStrategy) ([]Amount, error) {
+	q := c.divide(a, int64(n))
+	r := c.modulus(a, int64(n))
+	as := make([]Amount, n)
+	for i := range as {
+		as[i] = q
+	}
+
+	switch s {
+	case RemainderFirst:
+		as[0] = c.add(as[0], r)
+	case RemainderLast:
+		as[n-1] = c.add(as[n-1], r)
+	case RemainderRoundRobin:
+		c.spread(as, r)
+	case RemainderReverseRoundRobin:
+		// Spreading the remainder over as many last parts gives each of them one unit.
+		c.spread(as[n-int(c.absolute(r)):], r)
+	default:
+		return nil, errors.New("unknown remainder strategy")
+	}
+
+	return as, nil
+}
+
+// roundTo rounds a to the nearest multiple of step, halves away from zero, or returns ErrOverflow
+// if the multiple doesn't fit into an Amount.
+func (c *calculator) roundTo(a Amount, step int64) (Amount, error) {
+	q, err := c.multiplyRatio(a, 1, step)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.multiplyRatio(q, step, 1)
+}
+
+// charm returns the closest amounts lower or equal and higher or equal to a ending with e.
+func (c *calculator) charm(a Amount, e int64) (Amount, Amount) {
+	mod := int64(10)
+	for mod <= e {
+		mod *= 10
+	}
+
+	down := a - (a-e)%mod
+	if (a-e)%mod < 0 {
+		down -= mod
+	}
+
+	if down == a {
+		return a, a
+	}
+
+	return down, down + mod
+}
@@ -0,0 +1,76 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RemainderCarrier applies rates to Money while carrying the fractions of the smallest unit forward,
+// so that repeated operations, like charging a daily interest or a commission on every payment,
+// don't drift from the exact total. Each result is truncated towards zero, and the residue is added
+// to the next one, releasing a whole unit as soon as it accrues.
+//
+//	c, _ := money.NewRemainderCarrier(money.EUR)
+//	fee, _ := c.MultiplyRate(payment, money.Rate{Numerator: 29, Denominator: 1000}) // 2.9%
+type RemainderCarrier struct {
+	zero    *Money
+	residue *big.Rat
+}
+
+// NewRemainderCarrier creates and returns new RemainderCarrier for the given currency with no residue.
+func NewRemainderCarrier(currencyCode string) (*RemainderCarrier, error) {
+	zero, err := New(0, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RemainderCarrier{zero: zero, residue: new(big.Rat)}, nil
+}
+
+// MultiplyRate returns m multiplied by r plus the carried residue, truncated to the currency's smallest unit,
+// and carries the truncated part forward.
+func (c *RemainderCarrier) MultiplyRate(m *Money, r Rate) (*Money, error) {
+	if err := c.zero.assertSameCurrency(m); err != nil {
+		return nil, err
+	}
+
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	exact := new(big.Rat)
+	if !r.IsZero() {
+		exact.SetFrac(big.NewInt(m.amount), big.NewInt(r.Denominator))
+		exact.Mul(exact, new(big.Rat).SetInt64(r.Numerator))
+	}
+	exact.Add(exact, c.residue)
+
+	a, err := roundRat(exact, RoundDown)
+	if err != nil {
+		return nil, err
+	}
+
+	c.residue = exact.Sub(exact, new(big.Rat).SetInt64(a))
+	return c.zero.WithAmount(a), nil
+}
+
+// Residue returns the carried fraction of the smallest unit, always strictly between -1 and 1.
+func (c *RemainderCarrier) Residue() *big.Rat {
+	return new(big.Rat).Set(c.residue)
+}
+
+// Flush rounds the carried residue to a whole smallest unit using mode, e.g. at the end of a billing period,
+// and returns it as Money, resetting the residue to zero.
+func (c *RemainderCarrier) Flush(mode RoundingMode) (*Money, error) {
+	if err := mode.validate(); err != nil {
+		return nil, err
+	}
+
+	a, err := roundRat(c.residue, mode)
+	if err != nil {
+		return nil, fmt.Errorf("flush residue: %w", err)
+	}
+
+	c.residue = new(big.Rat)
+	return c.zero.WithAmount(a), nil
+}
@@ -0,0 +1,137 @@
+package money
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestRemainderCarrier_MultiplyRate(t *testing.T) {
+	c, err := NewRemainderCarrier(EUR)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 1/3 of 0.01 every day releases a cent every third day.
+	m, _ := New(1, EUR)
+	third := Rate{Numerator: 1, Denominator: 3}
+
+	var total int64
+	expected := []int64{0, 0, 1, 0, 0, 1}
+	for i, e := range expected {
+		r, err := c.MultiplyRate(m, third)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.AmountUnformatted() != e {
+			t.Errorf("Expected day %d to release %d got %d", i, e, r.AmountUnformatted())
+		}
+		total += r.AmountUnformatted()
+	}
+
+	if total != 2 || c.Residue().Sign() != 0 {
+		t.Errorf("Expected total %d and no residue got %d and %v", 2, total, c.Residue())
+	}
+
+	// 2.9% of 12.34 a thousand times is exactly 357.86 while rounding each fee drifts to 360.00.
+	c, _ = NewRemainderCarrier(EUR)
+	m, _ = New(1234, EUR)
+	fee := Rate{Numerator: 29, Denominator: 1000}
+
+	total = 0
+	for i := 0; i < 1000; i++ {
+		r, _ := c.MultiplyRate(m, fee)
+		total += r.AmountUnformatted()
+	}
+
+	if total != 35786 {
+		t.Errorf("Expected total %d got %d", 35786, total)
+	}
+
+	if each, _ := fee.apply(1234); 1000*each == total {
+		t.Errorf("Expected rounding every fee to drift from %d", total)
+	}
+}
+
+func TestRemainderCarrier_Negative(t *testing.T) {
+	c, _ := NewRemainderCarrier(USD)
+
+	m, _ := New(-1, USD)
+	half := Rate{Numerator: 1, Denominator: 2}
+	for i, e := range []int64{0, -1, 0, -1} {
+		r, _ := c.MultiplyRate(m, half)
+		if r.AmountUnformatted() != e {
+			t.Errorf("Expected step %d to release %d got %d", i, e, r.AmountUnformatted())
+		}
+	}
+
+	// Opposite residues cancel out.
+	p, _ := New(1, USD)
+	_, _ = c.MultiplyRate(p, half)
+	if r, _ := c.MultiplyRate(m, half); !r.IsZero() || c.Residue().Sign() != 0 {
+		t.Errorf("Expected residues to cancel got %v and %v", r, c.Residue())
+	}
+}
+
+func TestRemainderCarrier_Flush(t *testing.T) {
+	c, _ := NewRemainderCarrier(EUR)
+	m, _ := New(5, EUR)
+	_, _ = c.MultiplyRate(m, Rate{Numerator: 1, Denominator: 10})
+
+	if c.Residue().Cmp(big.NewRat(1, 2)) != 0 {
+		t.Errorf("Expected residue %v got %v", big.NewRat(1, 2), c.Residue())
+	}
+
+	tcs := []struct {
+		mode     RoundingMode
+		expected int64
+	}{
+		{RoundHalfUp, 1},
+		{RoundHalfDown, 0},
+		{RoundFloor, 0},
+	}
+
+	for _, tc := range tcs {
+		c, _ := NewRemainderCarrier(EUR)
+		_, _ = c.MultiplyRate(m, Rate{Numerator: 1, Denominator: 10})
+
+		r, err := c.Flush(tc.mode)
+		if err != nil || r.AmountUnformatted() != tc.expected {
+			t.Errorf("Expected flush with %v to give %d got %v, %v", tc.mode, tc.expected, r, err)
+		}
+
+		if c.Residue().Sign() != 0 {
+			t.Errorf("Expected flush to reset residue got %v", c.Residue())
+		}
+	}
+
+	if _, err := c.Flush(RoundingMode(42)); err == nil {
+		t.Error("Expected error for invalid rounding mode")
+	}
+}
+
+func TestRemainderCarrier_Errors(t *testing.T) {
+	if _, err := NewRemainderCarrier("XXX"); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+
+	c, _ := NewRemainderCarrier(EUR)
+	u, _ := New(1, USD)
+	if _, err := c.MultiplyRate(u, Rate{1, 2}); err != ErrCurrencyMismatch {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	m, _ := New(math.MaxInt64, EUR)
+	if _, err := c.MultiplyRate(m, Rate{3, 2}); err != ErrOverflow {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if _, err := c.MultiplyRate(m, Rate{1, 0}); err == nil {
+		t.Error("Expected error for invalid rate")
+	}
+
+	if c.Residue().Sign() != 0 {
+		t.Errorf("Expected failed operations to leave no residue got %v", c.Residue())
+	}
+}
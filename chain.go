@@ -0,0 +1,130 @@
+package money
+
+import "errors"
+
+// MoneyChain chains arithmetic on Money, remembering the first error and skipping
+// all following operations, so that it only needs to be checked once with Result.
+// Unlike the plain Money operations, additions, subtractions and multiplications
+// in a chain fail with ErrOverflow instead of wrapping around.
+type MoneyChain struct {
+	m   *Money
+	err error
+}
+
+// Chain starts a chain of operations on m.
+//
+//	total, err := money.Chain(price).Multiply(3).Add(shipping).Subtract(discount).Result()
+func Chain(m *Money) *MoneyChain {
+	if m == nil {
+		return &MoneyChain{err: errors.New("chain started with nil money")}
+	}
+
+	return &MoneyChain{m: m}
+}
+
+// Add adds om to the chained value.
+func (c *MoneyChain) Add(om *Money) *MoneyChain {
+	if c.err != nil {
+		return c
+	}
+
+	if c.err = c.m.assertSameCurrency(om); c.err != nil {
+		return c
+	}
+
+	a, err := mutate.calc.addChecked(c.m.amount, om.amount)
+	return c.set(a, err)
+}
+
+// Subtract subtracts om from the chained value.
+func (c *MoneyChain) Subtract(om *Money) *MoneyChain {
+	if c.err != nil {
+		return c
+	}
+
+	if c.err = c.m.assertSameCurrency(om); c.err != nil {
+		return c
+	}
+
+	a, err := mutate.calc.subtractChecked(c.m.amount, om.amount)
+	return c.set(a, err)
+}
+
+// Multiply multiplies the chained value by mul.
+func (c *MoneyChain) Multiply(mul int64) *MoneyChain {
+	if c.err != nil {
+		return c
+	}
+
+	a, err := mutate.calc.mulDivRound(c.m.amount, mul, 1, RoundHalfUp)
+	return c.set(a, err)
+}
+
+// MultiplyRational multiplies the chained value by numerator/denominator, rounded using mode.
+func (c *MoneyChain) MultiplyRational(numerator, denominator int64, mode RoundingMode) *MoneyChain {
+	if c.err == nil {
+		c.m, c.err = c.m.MultiplyRational(numerator, denominator, mode)
+	}
+
+	return c
+}
+
+// DivideExact divides the chained value by divisor, failing with ErrInexactDivision on any remainder.
+func (c *MoneyChain) DivideExact(divisor int64) *MoneyChain {
+	if c.err == nil {
+		c.m, c.err = c.m.DivideExact(divisor)
+	}
+
+	return c
+}
+
+// Percent replaces the chained value with the given Rate of it.
+func (c *MoneyChain) Percent(r Rate) *MoneyChain {
+	if c.err == nil {
+		c.m, c.err = c.m.Percent(r)
+	}
+
+	return c
+}
+
+// Absolute replaces the chained value with its absolute value.
+func (c *MoneyChain) Absolute() *MoneyChain {
+	if c.err == nil {
+		c.m = c.m.Absolute()
+	}
+
+	return c
+}
+
+// Negative replaces the chained value with its negative value.
+func (c *MoneyChain) Negative() *MoneyChain {
+	if c.err == nil {
+		c.m = c.m.Negative()
+	}
+
+	return c
+}
+
+// Err returns the first error which happened in the chain.
+func (c *MoneyChain) Err() error {
+	return c.err
+}
+
+// Result returns the chained value or the first error which happened in the chain.
+func (c *MoneyChain) Result() (*Money, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return c.m, nil
+}
+
+func (c *MoneyChain) set(a Amount, err error) *MoneyChain {
+	if err != nil {
+		c.m, c.err = nil, err
+		return c
+	}
+
+	c.m = &Money{amount: a, currency: c.m.currency}
+	return c
+}
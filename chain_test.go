@@ -0,0 +1,70 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	price, _ := New(1250, EUR)
+	shipping, _ := New(499, EUR)
+	discount, _ := New(300, EUR)
+
+	r, err := Chain(price).Multiply(3).Add(shipping).Subtract(discount).Percent(Rate{Numerator: 1, Denominator: 2}).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// (1250 * 3 + 499 - 300) / 2 = 1974.5
+	if r.amount != 1975 || r.currency.Code != EUR {
+		t.Errorf("Expected %d got %d", 1975, r.amount)
+	}
+
+	r, err = Chain(price).Negative().DivideExact(5).MultiplyRational(1, 3, RoundDown).Absolute().Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.amount != 83 {
+		t.Errorf("Expected %d got %d", 83, r.amount)
+	}
+
+	if price.amount != 1250 {
+		t.Errorf("Expected chain to leave %d untouched got %d", 1250, price.amount)
+	}
+}
+
+func TestChain_Errors(t *testing.T) {
+	eur, _ := New(100, EUR)
+	usd, _ := New(100, USD)
+	max, _ := New(math.MaxInt64, EUR)
+	min, _ := New(math.MinInt64, EUR)
+
+	tcs := []struct {
+		chain    *MoneyChain
+		expected error
+	}{
+		{Chain(eur).Add(usd).Add(eur), ErrCurrencyMismatch},
+		{Chain(eur).Subtract(usd), ErrCurrencyMismatch},
+		{Chain(max).Add(eur).Subtract(eur), ErrOverflow},
+		{Chain(min).Subtract(eur), ErrOverflow},
+		{Chain(max).Multiply(2).Add(usd), ErrOverflow},
+		{Chain(eur).DivideExact(3).Add(usd), ErrInexactDivision},
+	}
+
+	for i, tc := range tcs {
+		r, err := tc.chain.Result()
+		if !errors.Is(err, tc.expected) || r != nil {
+			t.Errorf("Expected chain %d to fail with %v got %v", i, tc.expected, err)
+		}
+
+		if tc.chain.Err() != err {
+			t.Errorf("Expected Err to return %v got %v", err, tc.chain.Err())
+		}
+	}
+
+	if _, err := Chain(nil).Add(eur).Result(); err == nil {
+		t.Error("Expected error for nil money")
+	}
+}
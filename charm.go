@@ -0,0 +1,71 @@
+package money
+
+import (
+	"errors"
+)
+
+// CharmPolicy decides in which direction a price is moved to reach a charm ending.
+type CharmPolicy int
+
+const (
+	// CharmUp moves the price to the closest charm price higher than or equal to it.
+	CharmUp CharmPolicy = iota
+	// CharmDown moves the price to the closest charm price lower than or equal to it.
+	CharmDown
+	// CharmNearest moves the price to the closest charm price in either direction, preferring up on ties.
+	CharmNearest
+)
+
+// RoundToCharm returns new Money struct with value rounded to a psychological price ending
+// along with the adjustment made, i.e. the rounded value minus the original one.
+//
+// Endings are given in the currency's smallest unit and are matched against as many trailing
+// digits as they have, so for USD 99 rounds 12.34 to 12.99 or 11.99 while 999 rounds it to 19.99 or 9.99.
+// When several endings are given the closest resulting price according to policy wins.
+func (m *Money) RoundToCharm(policy CharmPolicy, endings ...int64) (*Money, *Money, error) {
+	if len(endings) == 0 {
+		return nil, nil, errors.New("no charm endings specified")
+	}
+
+	if m.amount < 0 {
+		return nil, nil, errors.New("can't round negative amount to charm price")
+	}
+
+	var best Amount
+	found := false
+	for _, e := range endings {
+		if e < 0 {
+			return nil, nil, errors.New("negative charm endings not allowed")
+		}
+
+		down, up := mutate.calc.charm(m.amount, e)
+		var c Amount
+		switch policy {
+		case CharmUp:
+			c = up
+		case CharmDown:
+			if down < 0 {
+				continue
+			}
+			c = down
+		case CharmNearest:
+			c = up
+			if down >= 0 && m.amount-down < up-m.amount {
+				c = down
+			}
+		default:
+			return nil, nil, errors.New("unknown charm policy")
+		}
+
+		if !found || mutate.calc.absolute(c-m.amount) < mutate.calc.absolute(best-m.amount) {
+			best, found = c, true
+		}
+	}
+
+	if !found {
+		return nil, nil, errors.New("no charm price lower than amount")
+	}
+
+	return &Money{amount: best, currency: m.currency},
+		&Money{amount: mutate.calc.subtract(best, m.amount), currency: m.currency}, nil
+}
@@ -0,0 +1,64 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestMoney_RoundToCharm(t *testing.T) {
+	tcs := []struct {
+		amount     int64
+		policy     CharmPolicy
+		endings    []int64
+		expected   int64
+		adjustment int64
+	}{
+		{1234, CharmUp, []int64{99}, 1299, 65},
+		{1234, CharmDown, []int64{99}, 1199, -35},
+		{1234, CharmNearest, []int64{99}, 1199, -35},
+		{1280, CharmNearest, []int64{99}, 1299, 19},
+		{1299, CharmDown, []int64{99}, 1299, 0},
+		{1234, CharmUp, []int64{999}, 1999, 765},
+		{1234, CharmDown, []int64{999}, 999, -235},
+		{1234, CharmUp, []int64{99, 95, 49}, 1249, 15},
+		{1296, CharmDown, []int64{99, 95}, 1295, -1},
+		{1234, CharmUp, []int64{5}, 1235, 1},
+		{50, CharmDown, []int64{99, 49}, 49, -1},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, USD)
+		r, adj, err := m.RoundToCharm(tc.policy, tc.endings...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.amount != tc.expected || adj.amount != tc.adjustment {
+			t.Errorf("Expected %d rounded to %v to be %d (%d) got %d (%d)", tc.amount, tc.endings, tc.expected, tc.adjustment, r.amount, adj.amount)
+		}
+	}
+}
+
+func TestMoney_RoundToCharm2(t *testing.T) {
+	m, _ := New(50, USD)
+
+	if _, _, err := m.RoundToCharm(CharmUp); err == nil {
+		t.Error("Expected error for missing endings")
+	}
+
+	if _, _, err := m.RoundToCharm(CharmDown, 99); err == nil {
+		t.Error("Expected error for no lower charm price")
+	}
+
+	if _, _, err := m.RoundToCharm(CharmUp, -1); err == nil {
+		t.Error("Expected error for negative ending")
+	}
+
+	if _, _, err := m.RoundToCharm(CharmPolicy(42), 99); err == nil {
+		t.Error("Expected error for unknown policy")
+	}
+
+	m, _ = New(-50, USD)
+	if _, _, err := m.RoundToCharm(CharmUp, 99); err == nil {
+		t.Error("Expected error for negative amount")
+	}
+}
@@ -0,0 +1,194 @@
+// Command money formats, converts, splits and parses amounts from the command line.
+//
+//	money format 1234.5 EUR                  €1234.50
+//	money convert -rate 1.0832 100 EUR USD   $108.32
+//	money split 100 EUR 3                    €33.34 €33.33 €33.33
+//	money split -ratios 1,2 100 EUR          €33.34 €66.66
+//	money parse "EUR 12.34"                  {"amount": "12.34", "currency": "EUR"}
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	money "github.com/bluelabs-eu/go-money"
+)
+
+const usage = `usage: money <command> [flags] [arguments]
+
+commands:
+  format [-amount] <amount> <currency>
+  convert -rate <rate> <amount> <from> <to>
+  split [-ratios r1,r2,...] <amount> <currency> [parts]
+  parse <"CODE amount">
+`
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "money:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(usage)
+	}
+
+	switch args[0] {
+	case "format":
+		return format(args[1:], out)
+	case "convert":
+		return convert(args[1:], out)
+	case "split":
+		return split(args[1:], out)
+	case "parse":
+		return parse(args[1:], out)
+	}
+
+	return fmt.Errorf("unknown command '%s'\n%s", args[0], usage)
+}
+
+func format(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("format", flag.ContinueOnError)
+	amountOnly := fs.Bool("amount", false, "print the amount without the currency template")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	m, err := parseArgs(fs.Args(), 2)
+	if err != nil {
+		return err
+	}
+
+	if *amountOnly {
+		fmt.Fprintln(out, m.Amount())
+		return nil
+	}
+
+	fmt.Fprintln(out, m.Display())
+	return nil
+}
+
+func convert(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("convert", flag.ContinueOnError)
+	rate := fs.String("rate", "", "units of the target currency worth one unit of the source currency")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	m, err := parseArgs(fs.Args(), 3)
+	if err != nil {
+		return err
+	}
+
+	if *rate == "" {
+		return errors.New("convert needs -rate")
+	}
+
+	r, err := money.ParseRate(*rate)
+	if err != nil {
+		return err
+	}
+
+	to := fs.Arg(2)
+	table := money.RateTable{}
+	table.Set(m.CurrencyCode(), to, r)
+
+	c, _, err := m.Convert(to, table)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out, c.Display())
+	return nil
+}
+
+func split(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("split", flag.ContinueOnError)
+	ratios := fs.String("ratios", "", "comma separated ratios to allocate by instead of splitting evenly")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	n := 2
+	if *ratios == "" {
+		n = 3
+	}
+
+	m, err := parseArgs(fs.Args(), n)
+	if err != nil {
+		return err
+	}
+
+	var parts []*money.Money
+	if *ratios != "" {
+		var rs []int
+		for _, s := range strings.Split(*ratios, ",") {
+			r, err := strconv.Atoi(strings.TrimSpace(s))
+			if err != nil {
+				return fmt.Errorf("invalid ratio '%s'", s)
+			}
+			rs = append(rs, r)
+		}
+
+		parts, err = m.Allocate(rs...)
+	} else {
+		var p int
+		if p, err = strconv.Atoi(fs.Arg(2)); err != nil {
+			return fmt.Errorf("invalid number of parts '%s'", fs.Arg(2))
+		}
+
+		parts, err = m.Split(p)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	ds := make([]string, len(parts))
+	for i, p := range parts {
+		ds[i] = p.Display()
+	}
+
+	fmt.Fprintln(out, strings.Join(ds, " "))
+	return nil
+}
+
+func parse(args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return errors.New(`parse needs one argument like "EUR 12.34"`)
+	}
+
+	var m money.Money
+	if err := m.UnmarshalText([]byte(args[0])); err != nil {
+		return err
+	}
+
+	b, err := m.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out, string(b))
+	return nil
+}
+
+// parseArgs parses Money from the first two of exactly n arguments, an amount and a currency code.
+func parseArgs(args []string, n int) (*money.Money, error) {
+	if len(args) != n {
+		return nil, fmt.Errorf("expected %d arguments got %d\n%s", n, len(args), usage)
+	}
+
+	var m money.Money
+	if err := m.UnmarshalText([]byte(args[1] + " " + args[0])); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tcs := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"format", "1234.5", "EUR"}, "€1234.50\n"},
+		{[]string{"format", "-amount", "--", "-0.05", "USD"}, "-0.05\n"},
+		{[]string{"convert", "-rate", "1.0832", "100", "EUR", "USD"}, "$108.32\n"},
+		{[]string{"convert", "-rate", "150.5", "10", "USD", "JPY"}, "¥1505\n"},
+		{[]string{"split", "100", "EUR", "3"}, "€33.34 €33.33 €33.33\n"},
+		{[]string{"split", "-ratios", "1,2", "100", "EUR"}, "€33.34 €66.66\n"},
+		{[]string{"parse", "EUR 12.34"}, `{"amount": "12.34", "currency": "EUR"}` + "\n"},
+	}
+
+	for _, tc := range tcs {
+		var out bytes.Buffer
+		if err := run(tc.args, &out); err != nil {
+			t.Fatalf("Unexpected error for %v: %v", tc.args, err)
+		}
+
+		if out.String() != tc.expected {
+			t.Errorf("Expected %v to print %q got %q", tc.args, tc.expected, out.String())
+		}
+	}
+}
+
+func TestRun_Errors(t *testing.T) {
+	tcs := [][]string{
+		{},
+		{"unknown"},
+		{"format", "12.34"},
+		{"format", "12.34", "XXX"},
+		{"format", "-unknown", "12.34", "EUR"},
+		{"convert", "100", "EUR", "USD"},
+		{"convert", "-rate", "abc", "100", "EUR", "USD"},
+		{"convert", "-rate", "1.1", "100", "EUR", "XXX"},
+		{"split", "100", "EUR", "zero"},
+		{"split", "100", "EUR", "0"},
+		{"split", "-ratios", "1,x", "100", "EUR"},
+		{"parse", "12.34"},
+		{"parse"},
+	}
+
+	for _, args := range tcs {
+		var out bytes.Buffer
+		if err := run(args, &out); err == nil {
+			t.Errorf("Expected error for %v", args)
+		}
+	}
+}
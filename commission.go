@@ -0,0 +1,110 @@
+package money
+
+import (
+	"errors"
+	"math"
+)
+
+// CommissionParty is a party taking a commission on a deal, a share of the gross and a fixed fee,
+// like a platform taking 10%, a referrer 2.5% and a payment processor €0.25.
+type CommissionParty struct {
+	Name string
+	Rate Rate
+	// Fixed is a fee taken on top of the share, nil for none.
+	Fixed *Money
+}
+
+// CommissionResult is the outcome of a commission calculation. The parties and the net always add up to the gross.
+type CommissionResult struct {
+	Gross *Money
+	// Parties holds the commission of every party, share and fixed fee, in the order given.
+	Parties []*Money
+	// Net is what's left for the payee.
+	Net *Money
+}
+
+// Commission calculates the commissions of the given parties on Self, the gross of a deal.
+// Shares are allocated from the gross together with the net, so that no penny is lost or made up:
+// shares are truncated and the leftover pennies go one by one to the net first, then to the parties in order. Fixed fees are then taken from the net,
+// failing if the commissions exceed the gross.
+func (m *Money) Commission(parties ...CommissionParty) (*CommissionResult, error) {
+	if m.IsNegative() {
+		return nil, errors.New("can't calculate commission of negative amount")
+	}
+
+	// Rates are brought to a common denominator to be used as allocation ratios, the net taking the rest.
+	d := int64(1)
+	for _, p := range parties {
+		if err := p.Rate.validate(); err != nil {
+			return nil, err
+		}
+
+		if p.Rate.Numerator < 0 {
+			return nil, errors.New("commission rate must not be negative")
+		}
+
+		if p.Rate.IsZero() {
+			continue
+		}
+
+		r := p.Rate.normalize()
+		g, b := d, r.Denominator
+		for b != 0 {
+			g, b = b, g%b
+		}
+
+		if d/g > math.MaxInt32/r.Denominator {
+			return nil, errors.New("commission rates are too precise")
+		}
+		d = d / g * r.Denominator
+	}
+
+	rs := make([]int, len(parties)+1)
+	rest := d
+	for i, p := range parties {
+		if p.Rate.IsZero() {
+			continue
+		}
+
+		r := p.Rate.normalize()
+		n := r.Numerator * (d / r.Denominator)
+		if n > rest {
+			return nil, errors.New("commission rates exceed 100%")
+		}
+
+		rs[i+1] = int(n)
+		rest -= n
+	}
+	rs[0] = int(rest)
+
+	shares, err := m.Allocate(rs...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &CommissionResult{Gross: m, Parties: shares[1:], Net: shares[0]}
+	for i, p := range parties {
+		if p.Fixed == nil {
+			continue
+		}
+
+		if err := m.assertSameCurrency(p.Fixed); err != nil {
+			return nil, err
+		}
+
+		if p.Fixed.IsNegative() {
+			return nil, errors.New("commission fee must not be negative")
+		}
+
+		if res.Parties[i], err = res.Parties[i].AddAll(p.Fixed); err != nil {
+			return nil, err
+		}
+
+		res.Net = &Money{amount: mutate.calc.subtract(res.Net.amount, p.Fixed.amount), currency: m.currency}
+		if res.Net.IsNegative() {
+			return nil, errors.New("commissions exceed gross")
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,75 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMoney_Commission(t *testing.T) {
+	fee, _ := New(25, EUR)
+
+	tcs := []struct {
+		gross    int64
+		parties  []CommissionParty
+		expected []int64
+		net      int64
+	}{
+		{10000, []CommissionParty{{Name: "platform", Rate: Rate{10, 100}}, {Name: "referrer", Rate: Rate{25, 1000}}, {Name: "processor", Fixed: fee}}, []int64{1000, 250, 25}, 8725},
+		// 87.5%, 10% and 2.5% of 9.99 are truncated to 8.74, 0.99 and 0.24, the net and the platform getting the two leftover pennies.
+		{999, []CommissionParty{{Rate: Rate{10, 100}}, {Rate: Rate{25, 1000}}}, []int64{100, 24}, 875},
+		{100, []CommissionParty{{Rate: Rate{1, 3}}, {Rate: Rate{1, 3}}}, []int64{33, 33}, 34},
+		{100, []CommissionParty{{Rate: Rate{1, 1}}}, []int64{100}, 0},
+		{0, []CommissionParty{{Rate: Rate{10, 100}, Fixed: &Money{currency: fee.currency}}}, []int64{0}, 0},
+		{100, nil, []int64{}, 100},
+	}
+
+	for _, tc := range tcs {
+		gross, _ := New(tc.gross, EUR)
+		r, err := gross.Commission(tc.parties...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sum := r.Net.amount
+		for i, p := range r.Parties {
+			sum += p.amount
+			if p.amount != tc.expected[i] {
+				t.Errorf("Expected party %d of %d to get %d got %d", i, tc.gross, tc.expected[i], p.amount)
+			}
+		}
+
+		if r.Net.amount != tc.net || sum != tc.gross {
+			t.Errorf("Expected net %d of %d got %d, parts adding up to %d", tc.net, tc.gross, r.Net.amount, sum)
+		}
+	}
+}
+
+func TestMoney_Commission_Errors(t *testing.T) {
+	gross, _ := New(100, EUR)
+	negative, _ := New(-100, EUR)
+	fee, _ := New(90, EUR)
+	usd, _ := New(1, USD)
+
+	tcs := []struct {
+		gross   *Money
+		parties []CommissionParty
+	}{
+		{negative, nil},
+		{gross, []CommissionParty{{Rate: Rate{60, 100}}, {Rate: Rate{50, 100}}}},
+		{gross, []CommissionParty{{Rate: Rate{-1, 100}}}},
+		{gross, []CommissionParty{{Rate: Rate{1, 0}}}},
+		{gross, []CommissionParty{{Rate: Rate{20, 100}, Fixed: fee}}},
+		{gross, []CommissionParty{{Fixed: negative}}},
+		{gross, []CommissionParty{{Rate: Rate{1, 999983}}, {Rate: Rate{1, 999979}}}},
+	}
+
+	for _, tc := range tcs {
+		if r, err := tc.gross.Commission(tc.parties...); err == nil {
+			t.Errorf("Expected error for %+v got %+v", tc.parties, r)
+		}
+	}
+
+	if _, err := gross.Commission(CommissionParty{Fixed: usd}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
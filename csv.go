@@ -0,0 +1,92 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+)
+
+// CSVLayout decides how Money is laid out in CSV columns.
+type CSVLayout int
+
+const (
+	// CSVCombined writes Money into a single column like "EUR 12.34".
+	CSVCombined CSVLayout = iota
+	// CSVSplit writes Money into an amount column followed by a currency column, like "12.34","EUR".
+	CSVSplit
+)
+
+// columns returns the number of columns used by the layout.
+func (l CSVLayout) columns() (int, error) {
+	switch l {
+	case CSVCombined:
+		return 1, nil
+	case CSVSplit:
+		return 2, nil
+	}
+
+	return 0, errors.New("unknown csv layout")
+}
+
+// MarshalCSV returns Money as a single CSV field like "EUR 12.34", as expected by gocarina/gocsv.
+// Zero value Money is written as an empty field.
+func (m Money) MarshalCSV() (string, error) {
+	if m == (Money{}) {
+		return "", nil
+	}
+
+	return m.CurrencyCode() + " " + m.Amount(), nil
+}
+
+// UnmarshalCSV parses Money from a single CSV field like "EUR 12.34", as expected by gocarina/gocsv.
+// An empty field is read as zero value Money.
+func (m *Money) UnmarshalCSV(field string) error {
+	if field == "" {
+		*m = Money{}
+		return nil
+	}
+
+	return m.UnmarshalText([]byte(field))
+}
+
+// CSVFields returns Money as CSV fields laid out according to layout,
+// to be written with encoding/csv along with the other columns of a record.
+func (m *Money) CSVFields(layout CSVLayout) ([]string, error) {
+	if _, err := layout.columns(); err != nil {
+		return nil, err
+	}
+
+	if layout == CSVSplit {
+		return []string{m.Amount(), m.CurrencyCode()}, nil
+	}
+
+	f, err := m.MarshalCSV()
+	return []string{f}, err
+}
+
+// FromCSVFields parses Money from CSV fields laid out according to layout,
+// e.g. a slice of the record read with encoding/csv.
+func FromCSVFields(fields []string, layout CSVLayout) (*Money, error) {
+	n, err := layout.columns()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) != n {
+		return nil, fmt.Errorf("expected %d csv fields got %d", n, len(fields))
+	}
+
+	if layout == CSVSplit {
+		if GetCurrency(fields[1]) == nil {
+			return nil, fmt.Errorf("invalid currency '%s'", fields[1])
+		}
+
+		return parseAmount(fields[0], fields[1])
+	}
+
+	m := &Money{}
+	if err := m.UnmarshalCSV(fields[0]); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
@@ -0,0 +1,109 @@
+package money
+
+import (
+	"bytes"
+	"encoding/csv"
+	"reflect"
+	"testing"
+)
+
+func TestMoney_MarshalCSV(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+	}{
+		{1234, EUR, "EUR 12.34"},
+		{-5, USD, "USD -0.05"},
+		{1000, JPY, "JPY 1000"},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		r, err := m.MarshalCSV()
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, r)
+		}
+
+		var u Money
+		if err := u.UnmarshalCSV(r); err != nil || u != *m {
+			t.Errorf("Expected %s to unmarshal to %v got %v", r, m, u)
+		}
+	}
+
+	var zero Money
+	if r, err := zero.MarshalCSV(); err != nil || r != "" {
+		t.Errorf("Expected empty field got %q", r)
+	}
+
+	m, _ := New(1, EUR)
+	if err := m.UnmarshalCSV(""); err != nil || *m != (Money{}) {
+		t.Errorf("Expected zero value got %v", m)
+	}
+
+	if err := m.UnmarshalCSV("12.34"); err == nil {
+		t.Error("Expected error for missing currency")
+	}
+}
+
+func TestMoney_CSVFields(t *testing.T) {
+	m, _ := New(123456, EUR)
+
+	tcs := []struct {
+		layout   CSVLayout
+		expected []string
+	}{
+		{CSVCombined, []string{"EUR 1234.56"}},
+		{CSVSplit, []string{"1234.56", "EUR"}},
+	}
+
+	for _, tc := range tcs {
+		fields, err := m.CSVFields(tc.layout)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(fields, tc.expected) {
+			t.Errorf("Expected %v got %v", tc.expected, fields)
+		}
+
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		_ = w.Write(append([]string{"invoice-1"}, fields...))
+		w.Flush()
+
+		record, err := csv.NewReader(&buf).Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r, err := FromCSVFields(record[1:], tc.layout)
+		if err != nil || *r != *m {
+			t.Errorf("Expected %v to read back as %v got %v", record, m, r)
+		}
+	}
+
+	if _, err := m.CSVFields(CSVLayout(42)); err == nil {
+		t.Error("Expected error for unknown layout")
+	}
+}
+
+func TestFromCSVFields_Errors(t *testing.T) {
+	tcs := []struct {
+		fields []string
+		layout CSVLayout
+	}{
+		{[]string{"12.34"}, CSVSplit},
+		{[]string{"12.34", "XXX"}, CSVSplit},
+		{[]string{"12.345", "EUR"}, CSVSplit},
+		{[]string{"EUR 12.34", "EUR"}, CSVCombined},
+		{[]string{"EUR twelve"}, CSVCombined},
+		{[]string{"EUR 12.34"}, CSVLayout(42)},
+	}
+
+	for _, tc := range tcs {
+		if _, err := FromCSVFields(tc.fields, tc.layout); err == nil {
+			t.Errorf("Expected error for %v", tc.fields)
+		}
+	}
+}
@@ -72,6 +72,80 @@ func TestCurrency_AddCurrency(t *testing.T) {
 	}
 }
 
+func TestRegisterFormatter(t *testing.T) {
+	defer RegisterFormatter(PLN, nil)
+
+	m, _ := New(123456, PLN)
+	micros, _ := m.ToMicros()
+
+	RegisterFormatter(PLN, NewFormatter(0, ",", " ", "zł", "1 $"))
+
+	tcs := []struct {
+		m        *Money
+		display  string
+		amount   string
+		original string
+	}{
+		{m, "1 234,56 zł", "1234.56", "1234.56 zł"},
+		{micros, "1 234,560000 zł", "1234.560000", "1234.560000 zł"},
+	}
+
+	for _, tc := range tcs {
+		if tc.m.Display() != tc.display || tc.m.Amount() != tc.amount {
+			t.Errorf("Expected %s and %s got %s and %s", tc.display, tc.amount, tc.m.Display(), tc.m.Amount())
+		}
+	}
+
+	if GetCurrency(PLN).Formatter().Fraction != 2 {
+		t.Errorf("Expected registered formatter to keep fraction %d got %d", 2, GetCurrency(PLN).Formatter().Fraction)
+	}
+
+	if m.AmountFixed() != "1234.56" {
+		t.Errorf("Expected %s got %s", "1234.56", m.AmountFixed())
+	}
+
+	RegisterFormatter(PLN, nil)
+	for _, tc := range tcs {
+		if tc.m.Display() != tc.original {
+			t.Errorf("Expected %s after removing the formatter got %s", tc.original, tc.m.Display())
+		}
+	}
+}
+
+func TestSetDisplayOverride(t *testing.T) {
+	defer SetDisplayOverride(CHF, DisplayOverride{})
+	defer RegisterFormatter(CHF, nil)
+
+	m, _ := New(-123456, CHF)
+
+	tcs := []struct {
+		override DisplayOverride
+		expected string
+	}{
+		{DisplayOverride{Grapheme: "Fr."}, "-1234.56 Fr."},
+		{DisplayOverride{Template: "$ 1"}, "-CHF 1234.56"},
+		{DisplayOverride{Grapheme: "Fr.", Template: "$1"}, "-Fr.1234.56"},
+		{DisplayOverride{}, "-1234.56 CHF"},
+	}
+
+	for _, tc := range tcs {
+		SetDisplayOverride(CHF, tc.override)
+		if m.Display() != tc.expected {
+			t.Errorf("Expected %+v to display %s got %s", tc.override, tc.expected, m.Display())
+		}
+
+		if r, _ := m.CashRound(); m.Amount() != "-1234.56" || r.AmountUnformatted() != -123455 {
+			t.Errorf("Expected override %+v not to affect amounts got %s", tc.override, m.Amount())
+		}
+	}
+
+	SetDisplayOverride(CHF, DisplayOverride{Grapheme: "Fr."})
+	RegisterFormatter(CHF, NewFormatter(2, ".", "'", "CHF", "$ 1"))
+	if m.Display() != "-CHF 1'234.56" {
+		t.Errorf("Expected registered formatter to take precedence got %s", m.Display())
+	}
+}
+
 func TestCurrency_GetCurrency(t *testing.T) {
 	code := "KLINGONDOLLAR"
 	desired := Currency{Decimal: ".", Thousand: ",", Code: code, Fraction: 2, Grapheme: "$", Template: "$1"}
@@ -0,0 +1,92 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// CurrencySet is a set of currency codes, like the currencies a merchant may settle in.
+// It's encoded in JSON as a sorted array of codes, e.g. ["EUR","USD"].
+type CurrencySet map[string]struct{}
+
+// NewCurrencySet creates and returns new CurrencySet of the given currency codes, failing on unknown ones.
+func NewCurrencySet(codes ...string) (CurrencySet, error) {
+	s := make(CurrencySet, len(codes))
+	for _, code := range codes {
+		if GetCurrency(code) == nil {
+			return nil, fmt.Errorf("invalid currency '%s'", code)
+		}
+		s[code] = struct{}{}
+	}
+
+	return s, nil
+}
+
+// Contains returns boolean of whether the set contains the given currency code.
+func (s CurrencySet) Contains(code string) bool {
+	_, ok := s[code]
+	return ok
+}
+
+// ContainsMoney returns boolean of whether the set contains the currency of m.
+func (s CurrencySet) ContainsMoney(m *Money) bool {
+	return s.Contains(m.CurrencyCode())
+}
+
+// Intersect returns new CurrencySet of the codes contained in both sets.
+func (s CurrencySet) Intersect(o CurrencySet) CurrencySet {
+	r := CurrencySet{}
+	for code := range s {
+		if o.Contains(code) {
+			r[code] = struct{}{}
+		}
+	}
+
+	return r
+}
+
+// Union returns new CurrencySet of the codes contained in either set.
+func (s CurrencySet) Union(o CurrencySet) CurrencySet {
+	r := make(CurrencySet, len(s)+len(o))
+	for code := range s {
+		r[code] = struct{}{}
+	}
+	for code := range o {
+		r[code] = struct{}{}
+	}
+
+	return r
+}
+
+// Codes returns the codes of the set in ascending order.
+func (s CurrencySet) Codes() []string {
+	codes := make([]string, 0, len(s))
+	for code := range s {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	return codes
+}
+
+// MarshalJSON is implementation of json.Marshaller
+func (s CurrencySet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Codes())
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller, failing on unknown currency codes.
+func (s *CurrencySet) UnmarshalJSON(b []byte) error {
+	var codes []string
+	if err := json.Unmarshal(b, &codes); err != nil {
+		return err
+	}
+
+	set, err := NewCurrencySet(codes...)
+	if err != nil {
+		return err
+	}
+
+	*s = set
+	return nil
+}
@@ -0,0 +1,72 @@
+package money
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestCurrencySet(t *testing.T) {
+	s, err := NewCurrencySet(USD, EUR, GBP, EUR)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(s.Codes(), []string{EUR, GBP, USD}) {
+		t.Errorf("Expected %v got %v", []string{EUR, GBP, USD}, s.Codes())
+	}
+
+	if !s.Contains(EUR) || s.Contains(JPY) {
+		t.Error("Expected set to contain EUR only and not JPY")
+	}
+
+	m, _ := New(1, GBP)
+	if !s.ContainsMoney(m) || s.ContainsMoney(&Money{}) {
+		t.Error("Expected set to contain GBP Money only")
+	}
+
+	o, _ := NewCurrencySet(JPY, USD, GBP)
+	if r := s.Intersect(o).Codes(); !reflect.DeepEqual(r, []string{GBP, USD}) {
+		t.Errorf("Expected %v got %v", []string{GBP, USD}, r)
+	}
+
+	if r := s.Union(o).Codes(); !reflect.DeepEqual(r, []string{EUR, GBP, JPY, USD}) {
+		t.Errorf("Expected %v got %v", []string{EUR, GBP, JPY, USD}, r)
+	}
+
+	var empty CurrencySet
+	if empty.Contains(EUR) || len(empty.Intersect(s)) != 0 || len(empty.Union(s)) != 3 {
+		t.Error("Expected nil set to be empty")
+	}
+
+	if _, err := NewCurrencySet(EUR, "XXX"); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+}
+
+func TestCurrencySet_JSON(t *testing.T) {
+	var config struct {
+		Settlement CurrencySet `json:"settlement"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"settlement": ["USD", "EUR"]}`), &config); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(config)
+	if err != nil || string(b) != `{"settlement":["EUR","USD"]}` {
+		t.Errorf("Expected %s got %s, %v", `{"settlement":["EUR","USD"]}`, b, err)
+	}
+
+	var empty CurrencySet
+	if b, _ := json.Marshal(empty); string(b) != "[]" {
+		t.Errorf("Expected %s got %s", "[]", b)
+	}
+
+	for _, in := range []string{`["XXX"]`, `"EUR"`, `[1]`} {
+		var s CurrencySet
+		if err := json.Unmarshal([]byte(in), &s); err == nil {
+			t.Errorf("Expected error for %s", in)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// decimal128Bias is the exponent bias of IEEE 754-2008 decimal128.
+const decimal128Bias = 6176
+
+// Decimal128 returns Money in major units as an IEEE 754-2008 decimal128 in the binary integer decimal encoding,
+// split into its high and low 64 bits, as used by BSON. Build a MongoDB primitive.Decimal128 from them with
+// primitive.NewDecimal128(high, low). The exponent keeps the decimal places of the currency, so €12.30
+// is encoded as 1230E-2.
+func (m *Money) Decimal128() (high, low uint64) {
+	high = uint64(decimal128Bias-m.currency.get().Fraction) << 49
+	if m.amount < 0 {
+		high |= 1 << 63
+	}
+
+	return high, magnitude(m.amount)
+}
+
+// FromDecimal128 creates and returns new Money from the high and low 64 bits of an IEEE 754-2008 decimal128
+// in the binary integer decimal encoding, e.g. as returned by GetBytes of a MongoDB primitive.Decimal128.
+// Values with more decimal places than the currency has fail unless the extra ones are zeros, and values
+// not fitting into an Amount fail with ErrOverflow. Non-canonical coefficients are read as zero, as the
+// standard requires, while infinities and NaN fail.
+func FromDecimal128(high, low uint64, currencyCode string) (*Money, error) {
+	currency := GetCurrency(currencyCode)
+	if currency == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", currencyCode)
+	}
+
+	negative := high>>63 == 1
+
+	var exp int
+	coeff := new(big.Int)
+	switch {
+	case high>>58&0x1f == 0x1f:
+		return nil, errors.New("decimal128 is NaN")
+	case high>>58&0x1f == 0x1e:
+		return nil, errors.New("decimal128 is infinite")
+	case high>>61&0x3 == 0x3:
+		// The coefficient would be above 2^113, more than the 34 digits allowed.
+		exp = int(high >> 47 & 0x3fff)
+	default:
+		exp = int(high >> 49 & 0x3fff)
+		coeff.SetUint64(high & (1<<49 - 1))
+		coeff.Lsh(coeff, 64).Or(coeff, new(big.Int).SetUint64(low))
+		if coeff.Cmp(new(big.Int).Sub(pow10(34), big.NewInt(1))) > 0 {
+			coeff.SetInt64(0)
+		}
+	}
+
+	if scale := exp - decimal128Bias + currency.Fraction; scale >= 0 {
+		coeff.Mul(coeff, pow10(scale))
+	} else {
+		var rem big.Int
+		if coeff.QuoRem(coeff, pow10(-scale), &rem); rem.Sign() != 0 {
+			return nil, fmt.Errorf("decimal128 has more than %d decimal places", currency.Fraction)
+		}
+	}
+
+	if negative {
+		coeff.Neg(coeff)
+	}
+
+	if !coeff.IsInt64() {
+		return nil, ErrOverflow
+	}
+
+	return &Money{amount: coeff.Int64(), currency: currency}, nil
+}
+
+// magnitude returns the absolute value of amount, math.MinInt64 included.
+func magnitude(amount int64) uint64 {
+	if amount < 0 {
+		return -uint64(amount)
+	}
+
+	return uint64(amount)
+}
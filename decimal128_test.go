@@ -0,0 +1,83 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMoney_Decimal128(t *testing.T) {
+	tcs := []struct {
+		amount    int64
+		code      string
+		high, low uint64
+	}{
+		{100, EUR, 0x303c000000000000, 100},
+		{-100, EUR, 0xb03c000000000000, 100},
+		{1, JPY, 0x3040000000000000, 1},
+		{-1234, BHD, 0xb03a000000000000, 1234},
+		{0, EUR, 0x303c000000000000, 0},
+		{math.MinInt64, EUR, 0xb03c000000000000, 1 << 63},
+		{math.MaxInt64, EUR, 0x303c000000000000, math.MaxInt64},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		high, low := m.Decimal128()
+		if high != tc.high || low != tc.low {
+			t.Errorf("Expected %x %x for %d %s got %x %x", tc.high, tc.low, tc.amount, tc.code, high, low)
+		}
+
+		r, err := FromDecimal128(high, low, tc.code)
+		if err != nil || *r != *m {
+			t.Errorf("Expected %v got %v, %v", m, r, err)
+		}
+	}
+}
+
+func TestFromDecimal128(t *testing.T) {
+	tcs := []struct {
+		high, low uint64
+		expected  int64
+	}{
+		// 12E+1 is 120.00.
+		{0x3042000000000000, 12, 12000},
+		// 1.2300E+0 is 1.23.
+		{0x3038000000000000, 12300, 123},
+		// A coefficient above 10^34 - 1 is non-canonical and read as zero.
+		{0x3041ffffffffffff, math.MaxUint64, 0},
+		// So are coefficients of the encoding above 2^113.
+		{0x6000000000000000, 1, 0},
+	}
+
+	for _, tc := range tcs {
+		m, err := FromDecimal128(tc.high, tc.low, EUR)
+		if err != nil || m.amount != tc.expected {
+			t.Errorf("Expected %d for %x %x got %v, %v", tc.expected, tc.high, tc.low, m, err)
+		}
+	}
+
+	errs := []struct {
+		high, low uint64
+	}{
+		// 1.5E-3 has more decimal places than EUR.
+		{0x3038000000000000, 15},
+		{0x7c00000000000000, 0},
+		{0x7800000000000000, 0},
+	}
+
+	for _, tc := range errs {
+		if m, err := FromDecimal128(tc.high, tc.low, EUR); err == nil {
+			t.Errorf("Expected error for %x %x got %v", tc.high, tc.low, m)
+		}
+	}
+
+	// 1E+30 doesn't fit into an Amount.
+	if _, err := FromDecimal128(0x307c000000000000, 1, EUR); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if _, err := FromDecimal128(0x3040000000000000, 1, "XXX"); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+}
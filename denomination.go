@@ -0,0 +1,95 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// denominations represents bills and coins in circulation per currency, in the currency's smallest unit.
+var denominations = map[string][]int64{
+	AUD: {10000, 5000, 2000, 1000, 500, 200, 100, 50, 20, 10, 5},
+	CAD: {10000, 5000, 2000, 1000, 500, 200, 100, 25, 10, 5},
+	CHF: {100000, 20000, 10000, 5000, 2000, 1000, 500, 200, 100, 50, 20, 10, 5},
+	EUR: {50000, 20000, 10000, 5000, 2000, 1000, 500, 200, 100, 50, 20, 10, 5, 2, 1},
+	GBP: {5000, 2000, 1000, 500, 200, 100, 50, 20, 10, 5, 2, 1},
+	JPY: {10000, 5000, 2000, 1000, 500, 100, 50, 10, 5, 1},
+	SEK: {100000, 50000, 20000, 10000, 5000, 2000, 1000, 500, 200, 100},
+	USD: {10000, 5000, 2000, 1000, 500, 200, 100, 50, 25, 10, 5, 1},
+}
+
+// Denomination is the number of bills or coins of the same value.
+type Denomination struct {
+	Value *Money
+	Count int64
+}
+
+// SetDenominations lets you insert or update bills and coins, in the currency's smallest unit,
+// used by Breakdown for the given currency.
+func SetDenominations(code string, values ...int64) error {
+	ds := make([]int64, 0, len(values))
+	for _, v := range values {
+		if v <= 0 {
+			return errors.New("denominations must be higher than zero")
+		}
+		ds = append(ds, v)
+	}
+
+	sort.Slice(ds, func(i, j int) bool { return ds[i] > ds[j] })
+	denominations[code] = ds
+	return nil
+}
+
+// GetDenominations returns bills and coins, in the currency's smallest unit, known for the given currency
+// from the highest to the lowest value.
+func GetDenominations(code string) []int64 {
+	ds, ok := denominations[code]
+	if !ok {
+		return nil
+	}
+
+	return append([]int64(nil), ds...)
+}
+
+// Breakdown returns the bills and coins making up Money using the denominations of its currency,
+// from the highest to the lowest value and skipping unused ones.
+// The largest denomination is always used first, which gives the fewest pieces for all real world currencies.
+// An error is returned for negative Money or when it can't be paid exactly, e.g. 0.01 CHF.
+func (m *Money) Breakdown() ([]*Denomination, error) {
+	ds, ok := denominations[m.CurrencyCode()]
+	if !ok {
+		return nil, fmt.Errorf("no denominations defined for currency '%s'", m.CurrencyCode())
+	}
+
+	return m.BreakdownWith(ds...)
+}
+
+// BreakdownWith works like Breakdown using the given denominations instead of the currency's ones,
+// for example to reflect what's actually available in a cash drawer.
+func (m *Money) BreakdownWith(values ...int64) ([]*Denomination, error) {
+	if m.amount < 0 {
+		return nil, errors.New("can't break down negative amount")
+	}
+
+	ds := append([]int64(nil), values...)
+	sort.Slice(ds, func(i, j int) bool { return ds[i] > ds[j] })
+
+	rest := m.amount
+	var res []*Denomination
+	for _, d := range ds {
+		if d <= 0 {
+			return nil, errors.New("denominations must be higher than zero")
+		}
+
+		if c := mutate.calc.divide(rest, d); c > 0 {
+			res = append(res, &Denomination{Value: &Money{amount: d, currency: m.currency}, Count: c})
+			rest = mutate.calc.modulus(rest, d)
+		}
+	}
+
+	if rest != 0 {
+		return nil, errors.New("amount can't be broken down into given denominations")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,94 @@
+package money
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMoney_Breakdown(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected map[int64]int64
+	}{
+		{0, EUR, map[int64]int64{}},
+		{18888, EUR, map[int64]int64{10000: 1, 5000: 1, 2000: 1, 1000: 1, 500: 1, 200: 1, 100: 1, 50: 1, 20: 1, 10: 1, 5: 1, 2: 1, 1: 1}},
+		{4099, USD, map[int64]int64{2000: 2, 50: 1, 25: 1, 10: 2, 1: 4}},
+		{1235, CHF, map[int64]int64{1000: 1, 200: 1, 20: 1, 10: 1, 5: 1}},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		ds, err := m.Breakdown()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r := make(map[int64]int64)
+		for _, d := range ds {
+			r[d.Value.amount] = d.Count
+		}
+
+		if !reflect.DeepEqual(r, tc.expected) {
+			t.Errorf("Expected %d %s to break down to %v got %v", tc.amount, tc.code, tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_BreakdownWith(t *testing.T) {
+	m, _ := New(380, EUR)
+	ds, err := m.BreakdownWith(50, 200, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []int64{200, 1, 50, 3, 10, 3}
+	for i, d := range ds {
+		if d.Value.amount != expected[i*2] || d.Count != expected[i*2+1] {
+			t.Errorf("Expected %d x %d got %d x %d", expected[i*2+1], expected[i*2], d.Count, d.Value.amount)
+		}
+	}
+
+	if _, err := m.BreakdownWith(200, 500); err == nil {
+		t.Error("Expected error for amount not representable by denominations")
+	}
+
+	if _, err := m.BreakdownWith(0); err == nil {
+		t.Error("Expected error for zero denomination")
+	}
+}
+
+func TestMoney_Breakdown2(t *testing.T) {
+	m, _ := New(-100, EUR)
+	if _, err := m.Breakdown(); err == nil {
+		t.Error("Expected error for negative amount")
+	}
+
+	m, _ = New(1, CHF)
+	if _, err := m.Breakdown(); err == nil {
+		t.Error("Expected error for amount below smallest coin")
+	}
+
+	m, _ = New(100, AED)
+	if _, err := m.Breakdown(); err == nil {
+		t.Error("Expected error for currency without denominations")
+	}
+}
+
+func TestSetDenominations(t *testing.T) {
+	if err := SetDenominations("MOCK", 1, 100, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	if ds := GetDenominations("MOCK"); !reflect.DeepEqual(ds, []int64{100, 10, 1}) {
+		t.Errorf("Expected denominations to be sorted got %v", ds)
+	}
+
+	if err := SetDenominations("MOCK", -1); err == nil {
+		t.Error("Expected error for negative denomination")
+	}
+
+	if ds := GetDenominations("UNKNOWN"); ds != nil {
+		t.Errorf("Expected no denominations got %v", ds)
+	}
+}
@@ -0,0 +1,65 @@
+package money
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// bidiMarks strips the invisible bidirectional formatting characters UIs wrap amounts in,
+// like the right-to-left mark around Arabic graphemes.
+var bidiMarks = strings.NewReplacer(
+	"\u200e", "", "\u200f", "", "\u061c", "",
+	"\u202a", "", "\u202b", "", "\u202c", "", "\u202d", "", "\u202e", "",
+	"\u2066", "", "\u2067", "", "\u2068", "", "\u2069", "",
+)
+
+// ParseDisplay creates and returns new Money from a string in exactly the form Display produces for the
+// given currency, e.g. "-€1,234.56", honoring its grapheme, template and separators, including the ones
+// set with RegisterFormatter or SetDisplayOverride. Bidirectional marks are ignored.
+func ParseDisplay(s string, currencyCode string) (*Money, error) {
+	currency := GetCurrency(currencyCode)
+	if currency == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", currencyCode)
+	}
+
+	f := currency.formatter()
+	l := f.layout()
+	d := bidiMarks.Replace(s)
+
+	number := strings.TrimPrefix(d, "-")
+	negative := len(number) < len(d)
+	if !l.number || !strings.HasPrefix(number, l.prefix) || !strings.HasSuffix(number[len(l.prefix):], l.suffix) {
+		return nil, fmt.Errorf("invalid display '%s' for %s", s, currencyCode)
+	}
+	number = number[len(l.prefix) : len(number)-len(l.suffix)]
+
+	if f.Thousand != "" {
+		number = strings.Replace(number, f.Thousand, "", -1)
+	}
+
+	digits := number
+	if f.Fraction > 0 {
+		i := strings.Index(number, f.Decimal)
+		if i == -1 {
+			return nil, fmt.Errorf("invalid display '%s' for %s", s, currencyCode)
+		}
+		digits = number[:i] + number[i+len(f.Decimal):]
+	}
+
+	if negative {
+		digits = "-" + digits
+	}
+
+	a, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil || strings.HasPrefix(digits, "+") {
+		return nil, fmt.Errorf("invalid display '%s' for %s", s, currencyCode)
+	}
+
+	// Anything Display wouldn't produce itself, like misplaced separators or a negative zero, is rejected.
+	if f.Format(a) != d {
+		return nil, fmt.Errorf("invalid display '%s' for %s", s, currencyCode)
+	}
+
+	return &Money{amount: a, currency: currency}, nil
+}
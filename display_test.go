@@ -0,0 +1,105 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseDisplay(t *testing.T) {
+	tcs := []struct {
+		amount int64
+		code   string
+	}{
+		{123456, EUR},
+		{-123456, EUR},
+		{5, USD},
+		{-5, USD},
+		{0, GBP},
+		{1000, JPY},
+		{-1234567, BHD},
+		{123456, AED},
+		{-987654, CHF},
+		{math.MaxInt64, USD},
+		{math.MinInt64, USD},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		r, err := ParseDisplay(m.Display(), tc.code)
+		if err != nil {
+			t.Errorf("Unexpected error for %s: %v", m.Display(), err)
+			continue
+		}
+
+		if *r != *m {
+			t.Errorf("Expected %s to parse as %d got %d", m.Display(), tc.amount, r.AmountUnformatted())
+		}
+	}
+}
+
+func TestParseDisplay_Formatter(t *testing.T) {
+	defer RegisterFormatter(PLN, nil)
+	defer SetDisplayOverride(SAR, DisplayOverride{})
+
+	RegisterFormatter(PLN, NewFormatter(2, ",", " ", "zł", "1 $"))
+	SetDisplayOverride(SAR, DisplayOverride{Template: "$ 1"})
+
+	tcs := []struct {
+		display  string
+		code     string
+		expected int64
+	}{
+		{"-1 234 567,89 zł", PLN, -123456789},
+		{"0,05 zł", PLN, 5},
+		{"\u200f\ufdfc 12.34\u200f", SAR, 1234},
+		{"\u2067-\ufdfc 12.34\u2069", SAR, -1234},
+	}
+
+	for _, tc := range tcs {
+		r, err := ParseDisplay(tc.display, tc.code)
+		if err != nil || r.AmountUnformatted() != tc.expected {
+			t.Errorf("Expected %q to parse as %d got %v, %v", tc.display, tc.expected, r, err)
+		}
+	}
+}
+
+func TestParseDisplay_Errors(t *testing.T) {
+	defer RegisterFormatter(USD, nil)
+
+	tcs := []struct {
+		display string
+		code    string
+	}{
+		{"€12.34", "XXX"},
+		{"€12.34", USD},
+		{"$12.3", USD},
+		{"$12.345", USD},
+		{"$12", USD},
+		{"12.34", USD},
+		{"$-12.34", USD},
+		{"-$0.00", USD},
+		{"$012.34", USD},
+		{"$+12.34", USD},
+		{"$12.34 ", USD},
+		{"$92233720368547758.08", USD},
+		{"¥1000.00", JPY},
+		{"", EUR},
+	}
+
+	for _, tc := range tcs {
+		if _, err := ParseDisplay(tc.display, tc.code); err == nil {
+			t.Errorf("Expected error for %q", tc.display)
+		}
+	}
+
+	RegisterFormatter(USD, NewFormatter(2, ".", ",", "$", "$1"))
+	for _, d := range []string{"$1234.56", "$12,34.56", "$,123.45"} {
+		if _, err := ParseDisplay(d, USD); err == nil {
+			t.Errorf("Expected error for misplaced separators in %q", d)
+		}
+	}
+
+	if r, err := ParseDisplay("$1,234.56", USD); err != nil || r.AmountUnformatted() != 123456 {
+		t.Errorf("Expected %d got %v, %v", 123456, r, err)
+	}
+}
@@ -0,0 +1,22 @@
+package money
+
+// entColumnType is wide enough for "CODE amount" of any listed currency, with room for longer custom codes.
+const entColumnType = "varchar(64)"
+
+// EntSchemaType returns the column types of Money stored by Value in an ent schema, by ent dialect name.
+// Money implements field.ValueScanner, so it's declared as an Other field, persisting amount and currency
+// together, and regenerates without any custom template:
+//
+//	func (Order) Fields() []ent.Field {
+//		return []ent.Field{
+//			field.Other("price", &money.Money{}).
+//				SchemaType(money.EntSchemaType()),
+//		}
+//	}
+func EntSchemaType() map[string]string {
+	return map[string]string{
+		"mysql":    entColumnType,
+		"postgres": entColumnType,
+		"sqlite3":  entColumnType,
+	}
+}
@@ -0,0 +1,26 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEntSchemaType(t *testing.T) {
+	types := EntSchemaType()
+	for _, dialect := range []string{"mysql", "postgres", "sqlite3"} {
+		if types[dialect] != "varchar(64)" {
+			t.Errorf("Expected %s column type %s got %s", dialect, "varchar(64)", types[dialect])
+		}
+	}
+
+	// The longest value of a listed currency fits into the column.
+	m, _ := New(math.MinInt64, CLF)
+	if v, _ := m.Value(); len(v.(string)) > 64 {
+		t.Errorf("Expected %s to fit into %d characters", v, 64)
+	}
+
+	types["mysql"] = "text"
+	if EntSchemaType()["mysql"] != "varchar(64)" {
+		t.Error("Expected a new map on every call")
+	}
+}
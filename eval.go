@@ -0,0 +1,206 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// maxEvalDepth limits nesting of parentheses and unary operators in Eval expressions.
+const maxEvalDepth = 64
+
+// Eval evaluates an arithmetic expression like "(10.00 + 2.50) * 3" into Money of the given currency,
+// e.g. to execute pricing rules stored as strings.
+//
+// Expressions support decimal numbers using "." as decimal separator, + - * / with the usual precedence,
+// unary minus, parentheses and percentages: "15%" is exactly 0.15, so "200 * 15%" is 30.00.
+// The expression is evaluated exactly and rounded once, half away from zero, to the currency's fraction.
+func Eval(expr string, currencyCode string) (*Money, error) {
+	return EvalWithMode(expr, currencyCode, RoundHalfUp)
+}
+
+// EvalWithMode works like Eval rounding the result using mode.
+func EvalWithMode(expr string, currencyCode string, mode RoundingMode) (*Money, error) {
+	currency := GetCurrency(currencyCode)
+	if currency == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", currencyCode)
+	}
+
+	if err := mode.validate(); err != nil {
+		return nil, err
+	}
+
+	p := &evalParser{in: expr}
+	r, err := p.expr(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.skip(); p.pos < len(p.in) {
+		return nil, p.errorf("unexpected '%c'", p.in[p.pos])
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(currency.Fraction)), nil)
+	r.Mul(r, new(big.Rat).SetInt(scale))
+
+	a, err := roundRat(r, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: a, currency: currency}, nil
+}
+
+// roundRat rounds r to an Amount using mode.
+func roundRat(r *big.Rat, mode RoundingMode) (Amount, error) {
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if !q.IsInt64() {
+		return 0, ErrOverflow
+	}
+
+	v := q.Int64()
+	if rem.Sign() == 0 {
+		return v, nil
+	}
+
+	half := new(big.Int).Lsh(rem.Abs(rem), 1).Cmp(r.Denom())
+	if !mode.away(r.Sign() < 0, half, v%2 != 0) {
+		return v, nil
+	}
+
+	if r.Sign() < 0 {
+		return v - 1, nil
+	}
+
+	return v + 1, nil
+}
+
+// evalParser is a recursive descent parser evaluating expressions while parsing them.
+type evalParser struct {
+	in  string
+	pos int
+}
+
+func (p *evalParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("invalid expression at position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *evalParser) skip() {
+	for p.pos < len(p.in) && (p.in[p.pos] == ' ' || p.in[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+// peek returns the next non blank character, or 0 at the end of the input.
+func (p *evalParser) peek() byte {
+	if p.skip(); p.pos < len(p.in) {
+		return p.in[p.pos]
+	}
+
+	return 0
+}
+
+// expr parses term (('+' | '-') term)*.
+func (p *evalParser) expr(depth int) (*big.Rat, error) {
+	r, err := p.term(depth)
+	if err != nil {
+		return nil, err
+	}
+
+	for op := p.peek(); op == '+' || op == '-'; op = p.peek() {
+		p.pos++
+		o, err := p.term(depth)
+		if err != nil {
+			return nil, err
+		}
+
+		if op == '+' {
+			r.Add(r, o)
+		} else {
+			r.Sub(r, o)
+		}
+	}
+
+	return r, nil
+}
+
+// term parses factor (('*' | '/') factor)*.
+func (p *evalParser) term(depth int) (*big.Rat, error) {
+	r, err := p.factor(depth)
+	if err != nil {
+		return nil, err
+	}
+
+	for op := p.peek(); op == '*' || op == '/'; op = p.peek() {
+		p.pos++
+		o, err := p.factor(depth)
+		if err != nil {
+			return nil, err
+		}
+
+		if op == '*' {
+			r.Mul(r, o)
+			continue
+		}
+
+		if o.Sign() == 0 {
+			return nil, errors.New("division by zero")
+		}
+		r.Quo(r, o)
+	}
+
+	return r, nil
+}
+
+// factor parses '-' factor | '(' expr ')' '%'? | number '%'?.
+func (p *evalParser) factor(depth int) (*big.Rat, error) {
+	if depth >= maxEvalDepth {
+		return nil, p.errorf("expression nested too deeply")
+	}
+
+	var r *big.Rat
+	switch c := p.peek(); {
+	case c == '-':
+		p.pos++
+		o, err := p.factor(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return o.Neg(o), nil
+	case c == '(':
+		p.pos++
+		o, err := p.expr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, p.errorf("missing ')'")
+		}
+		p.pos++
+		r = o
+	case c >= '0' && c <= '9' || c == '.':
+		start := p.pos
+		for p.pos < len(p.in) && (p.in[p.pos] >= '0' && p.in[p.pos] <= '9' || p.in[p.pos] == '.') {
+			p.pos++
+		}
+
+		lit := p.in[start:p.pos]
+		o, ok := new(big.Rat).SetString(lit)
+		if !ok {
+			p.pos = start
+			return nil, p.errorf("invalid number '%s'", lit)
+		}
+		r = o
+	case c == 0:
+		return nil, p.errorf("unexpected end of expression")
+	default:
+		return nil, p.errorf("unexpected '%c'", c)
+	}
+
+	if p.peek() == '%' {
+		p.pos++
+		r.Quo(r, big.NewRat(100, 1))
+	}
+
+	return r, nil
+}
@@ -0,0 +1,100 @@
+package money
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEval(t *testing.T) {
+	tcs := []struct {
+		expr     string
+		code     string
+		expected int64
+	}{
+		{"(10.00 + 2.50) * 3", EUR, 3750},
+		{"10.00 + 2.50 * 3", EUR, 1750},
+		{"10 - 2 - 3", EUR, 500},
+		{"12 / 4 / 3", EUR, 100},
+		{"200 * 15%", EUR, 3000},
+		{"100 * (1 + 21%)", EUR, 12100},
+		{"-(1.5 - 3)", EUR, 150},
+		{"--1", EUR, 100},
+		{"10 / 3", EUR, 333},
+		{"20 / 3", EUR, 667},
+		{"0.005", EUR, 1},
+		{"-0.005", EUR, -1},
+		{"10 / 3 * 3", EUR, 1000},
+		{"1234.5", JPY, 1235},
+		{" 1.2345 ", BHD, 1235},
+		{"5%", EUR, 5},
+	}
+
+	for _, tc := range tcs {
+		r, err := Eval(tc.expr, tc.code)
+		if err != nil {
+			t.Fatalf("Unexpected error for %q: %v", tc.expr, err)
+		}
+
+		if r.amount != tc.expected || r.currency.Code != tc.code {
+			t.Errorf("Expected %q to be %d %s got %d %s", tc.expr, tc.expected, tc.code, r.amount, r.currency.Code)
+		}
+	}
+}
+
+func TestEvalWithMode(t *testing.T) {
+	tcs := []struct {
+		expr     string
+		mode     RoundingMode
+		expected int64
+	}{
+		{"0.025", RoundHalfUp, 3},
+		{"0.025", RoundHalfDown, 2},
+		{"0.025", RoundHalfEven, 2},
+		{"0.035", RoundHalfEven, 4},
+		{"0.021", RoundUp, 3},
+		{"-0.021", RoundUp, -3},
+		{"0.029", RoundDown, 2},
+		{"-0.021", RoundCeiling, -2},
+		{"-0.021", RoundFloor, -3},
+	}
+
+	for _, tc := range tcs {
+		r, err := EvalWithMode(tc.expr, EUR, tc.mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.amount != tc.expected {
+			t.Errorf("Expected %q with mode %d to be %d got %d", tc.expr, tc.mode, tc.expected, r.amount)
+		}
+	}
+}
+
+func TestEval_Errors(t *testing.T) {
+	tcs := []struct {
+		expr string
+		code string
+	}{
+		{"", EUR},
+		{"1 +", EUR},
+		{"(1 + 2", EUR},
+		{"1 + 2)", EUR},
+		{"1..2", EUR},
+		{"1 / (2 - 2)", EUR},
+		{"1 ^ 2", EUR},
+		{"abc", EUR},
+		{"100000000000000000", EUR},
+		{"1", "UNKNOWN"},
+		{strings.Repeat("(", 100) + "1" + strings.Repeat(")", 100), EUR},
+	}
+
+	for _, tc := range tcs {
+		if _, err := Eval(tc.expr, tc.code); err == nil {
+			t.Errorf("Expected error for %q", tc.expr)
+		}
+	}
+
+	if _, err := EvalWithMode("1", EUR, RoundingMode(-1)); err == nil {
+		t.Error("Expected error for unknown rounding mode")
+	}
+}
@@ -0,0 +1,55 @@
+package money
+
+import (
+	"strings"
+)
+
+// ExcelFormat returns the Excel number format matching how the formatter displays amounts, like
+// `"€"#,##0.00` or `#,##0.00" kr"`, to style the cells of spreadsheet exports, e.g. with excelize.
+// Excel renders grouping and decimal separators with those of the spreadsheet's locale, so only
+// whether amounts are grouped is taken from the formatter. Negative amounts get a leading minus
+// sign like with Display.
+func (f *Formatter) ExcelFormat() string {
+	number := "0"
+	if f.Thousand != "" {
+		number = "#,##0"
+	}
+
+	if f.Fraction > 0 {
+		number += "." + strings.Repeat("0", f.Fraction)
+	}
+
+	l := f.layout()
+	if !l.number {
+		return excelText(l.prefix)
+	}
+
+	return excelText(l.prefix) + number + excelText(l.suffix)
+}
+
+// ExcelFormat returns the Excel number format of the currency of Money, see Formatter.ExcelFormat.
+// The cell value is the amount in major units, as returned by AsMajorUnits.
+func (m *Money) ExcelFormat() string {
+	f := m.currency.get().formatter()
+	return f.ExcelFormat()
+}
+
+// excelText returns s as a literal of an Excel number format, quoted, or with every character escaped
+// if it contains quotes.
+func excelText(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	if !strings.Contains(s, `"`) {
+		return `"` + s + `"`
+	}
+
+	var b strings.Builder
+	for _, r := range s {
+		b.WriteByte('\\')
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,43 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestMoney_ExcelFormat(t *testing.T) {
+	tcs := []struct {
+		code     string
+		expected string
+	}{
+		{EUR, `"€"0.00`},
+		{JPY, `"¥"0`},
+		{SEK, `0.00" kr"`},
+		{BHD, `0.000" .د.ب"`},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(-123456, tc.code)
+		if f := m.ExcelFormat(); f != tc.expected {
+			t.Errorf("Expected %s for %s got %s", tc.expected, tc.code, f)
+		}
+	}
+}
+
+func TestFormatter_ExcelFormat(t *testing.T) {
+	tcs := []struct {
+		f        *Formatter
+		expected string
+	}{
+		{NewFormatter(2, ",", ".", "€", "1 $"), `#,##0.00" €"`},
+		{NewFormatter(2, ".", ",", "$", "$1"), `"$"#,##0.00`},
+		{NewFormatter(0, ".", "", "$", "$"), `"$"`},
+		{NewFormatter(3, ".", "'", `"x"`, "1 $"), `#,##0.000\ \"\x\"`},
+		{NewFormatter(2, ".", "", "", "1"), `0.00`},
+	}
+
+	for _, tc := range tcs {
+		if f := tc.f.ExcelFormat(); f != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, f)
+		}
+	}
+}
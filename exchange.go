@@ -0,0 +1,109 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrNoRate happens when a Converter doesn't know the exchange rate between two currencies.
+var ErrNoRate = errors.New("no exchange rate")
+
+// Converter provides exchange rates between currencies.
+type Converter interface {
+	// Rate returns how many units of currency to are worth one unit of currency from.
+	Rate(from, to string) (Rate, error)
+}
+
+// RateTable is a Converter backed by fixed exchange rates.
+// Inverse rates are derived automatically when only one direction is known.
+type RateTable map[string]map[string]Rate
+
+// Set updates the table by adding the exchange rate from one currency to another to it.
+func (t RateTable) Set(from, to string, r Rate) RateTable {
+	if t[from] == nil {
+		t[from] = make(map[string]Rate)
+	}
+
+	t[from][to] = r
+	return t
+}
+
+// Rate returns the exchange rate from one currency to another.
+func (t RateTable) Rate(from, to string) (Rate, error) {
+	if from == to {
+		return Rate{1, 1}, nil
+	}
+
+	if r, ok := t[from][to]; ok {
+		return r, nil
+	}
+
+	if r, ok := t[to][from]; ok && !r.IsZero() {
+		return Rate{Numerator: r.Denominator, Denominator: r.Numerator}, nil
+	}
+
+	return Rate{}, fmt.Errorf("%w from '%s' to '%s'", ErrNoRate, from, to)
+}
+
+// Convert returns new Money struct with value converted to the given currency using the converter,
+// along with the exchange rate applied. The amount is multiplied by the rate exactly and the result is rounded
+// half away from zero once, failing with ErrOverflow when it doesn't fit into an Amount.
+func (m *Money) Convert(currencyCode string, c Converter) (*Money, Rate, error) {
+	currency := GetCurrency(currencyCode)
+	if currency == nil {
+		return nil, Rate{}, fmt.Errorf("invalid currency '%s'", currencyCode)
+	}
+
+	return m.convert(currency, c)
+}
+
+func (m *Money) convert(currency *Currency, c Converter) (*Money, Rate, error) {
+	from := m.currency.get()
+	r, err := c.Rate(from.Code, currency.Code)
+	if err != nil {
+		return nil, Rate{}, err
+	}
+
+	if r.Numerator <= 0 || r.Denominator <= 0 {
+		return nil, Rate{}, errors.New("exchange rate must be higher than zero")
+	}
+
+	// amount * rate, rescaled between the fractions of the currencies, is computed exactly and rounded once.
+	n := new(big.Int).Mul(big.NewInt(m.amount), big.NewInt(r.Numerator))
+	d := big.NewInt(r.Denominator)
+	if e := currency.Fraction - from.Fraction; e > 0 {
+		n.Mul(n, pow10(e))
+	} else if e < 0 {
+		d.Mul(d, pow10(-e))
+	}
+
+	a, err := roundRat(new(big.Rat).SetFrac(n, d), RoundHalfUp)
+	if err != nil {
+		return nil, Rate{}, err
+	}
+
+	return &Money{amount: a, currency: currency}, r, nil
+}
+
+// CompareConverted converts the other Money into the currency of Self and compares them like Compare does,
+// returning the exchange rate applied.
+func (m *Money) CompareConverted(om *Money, c Converter) (int, Rate, error) {
+	converted, r, err := om.convert(m.currency.get(), c)
+	if err != nil {
+		return 0, Rate{}, err
+	}
+
+	return m.compare(converted), r, nil
+}
+
+// EqualsConverted converts the other Money into the currency of Self and checks their equality,
+// returning the exchange rate applied.
+func (m *Money) EqualsConverted(om *Money, c Converter) (bool, Rate, error) {
+	cmp, r, err := m.CompareConverted(om, c)
+	if err != nil {
+		return false, Rate{}, err
+	}
+
+	return cmp == 0, r, nil
+}
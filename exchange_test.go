@@ -0,0 +1,127 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestRateTable_Rate(t *testing.T) {
+	rates := RateTable{}.Set(EUR, USD, Rate{108, 100})
+
+	tcs := []struct {
+		from, to string
+		expected Rate
+	}{
+		{EUR, USD, Rate{108, 100}},
+		{USD, EUR, Rate{100, 108}},
+		{EUR, EUR, Rate{1, 1}},
+	}
+
+	for _, tc := range tcs {
+		r, err := rates.Rate(tc.from, tc.to)
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected rate from %s to %s to be %v got %v (%v)", tc.from, tc.to, tc.expected, r, err)
+		}
+	}
+
+	if _, err := rates.Rate(EUR, GBP); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected %v got %v", ErrNoRate, err)
+	}
+}
+
+func TestMoney_Convert(t *testing.T) {
+	rates := RateTable{}.
+		Set(EUR, USD, Rate{10847, 10000}).
+		Set(USD, JPY, Rate{15012, 100}).
+		Set(BHD, USD, Rate{265, 100})
+
+	tcs := []struct {
+		amount   int64
+		from, to string
+		expected int64
+	}{
+		{10000, EUR, USD, 10847},
+		{10847, USD, EUR, 10000},
+		{1, EUR, USD, 1},
+		{-1000, EUR, USD, -1085},
+		{100, USD, JPY, 150},
+		{15012, JPY, USD, 10000},
+		{1000, BHD, USD, 265},
+		// The product of amount and rate exceeds an int64.
+		{1000000000000000, EUR, USD, 1084700000000000},
+		{-math.MaxInt64 / 2, USD, EUR, -4251577411659802621},
+		{math.MaxInt64 / 200, USD, JPY, 69230630508631947},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.from)
+		r, _, err := m.Convert(tc.to, rates)
+
+		if err != nil || r.amount != tc.expected || r.currency.Code != tc.to {
+			t.Errorf("Expected %d %s to be %d %s got %v (%v)", tc.amount, tc.from, tc.expected, tc.to, r, err)
+		}
+	}
+
+	m, _ := New(100, EUR)
+	if _, _, err := m.Convert("UNKNOWN", rates); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+
+	if _, _, err := m.Convert(GBP, rates); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected %v got %v", ErrNoRate, err)
+	}
+
+	max, _ := New(math.MaxInt64, EUR)
+	if _, _, err := max.Convert(USD, rates); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if _, _, err := m.Convert(USD, RateTable{}.Set(EUR, USD, Rate{-1, 1})); err == nil {
+		t.Error("Expected error for negative rate")
+	}
+}
+
+func TestMoney_CompareConverted(t *testing.T) {
+	rates := RateTable{}.Set(EUR, USD, Rate{108, 100})
+	limit, _ := New(10000, USD)
+
+	tcs := []struct {
+		amount   int64
+		expected int
+	}{
+		{8000, 1},
+		{9258, 1},
+		{9259, 0},
+		{9260, -1},
+		{9300, -1},
+	}
+
+	for _, tc := range tcs {
+		eur, _ := New(tc.amount, EUR)
+		r, rate, err := limit.CompareConverted(eur, rates)
+
+		if err != nil || r != tc.expected || rate != (Rate{108, 100}) {
+			t.Errorf("Expected %d USD compared to %d EUR to be %d got %d (%v)", limit.amount, tc.amount, tc.expected, r, err)
+		}
+	}
+}
+
+func TestMoney_EqualsConverted(t *testing.T) {
+	rates := RateTable{}.Set(EUR, USD, Rate{108, 100})
+	usd, _ := New(10800, USD)
+	eur, _ := New(10000, EUR)
+	gbp, _ := New(10000, GBP)
+
+	if ok, rate, err := usd.EqualsConverted(eur, rates); err != nil || !ok || rate != (Rate{108, 100}) {
+		t.Errorf("Expected %d USD to equal %d EUR got %t (%v)", usd.amount, eur.amount, ok, err)
+	}
+
+	if ok, rate, err := eur.EqualsConverted(usd, rates); err != nil || !ok || rate != (Rate{100, 108}) {
+		t.Errorf("Expected %d EUR to equal %d USD got %t (%v)", eur.amount, usd.amount, ok, err)
+	}
+
+	if _, _, err := usd.EqualsConverted(gbp, rates); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected %v got %v", ErrNoRate, err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 // Formatter stores Money formatting information.
@@ -28,57 +29,186 @@ func NewFormatter(fraction int, decimal, thousand, grapheme, template string) *F
 
 // Format returns string of formatted integer using given currency template.
 func (f *Formatter) Format(amount int64) string {
-	// Work with absolute amount value
-	sa := strconv.FormatInt(f.abs(amount), 10)
+	var buf [20]byte
+	return f.format(appendMagnitude(buf[:0], amount), amount < 0, true)
+}
+
+// FormatAmount returns string of formatted integer without the currency template.
+func (f *Formatter) FormatAmount(amount int64) string {
+	var buf [20]byte
+	return f.format(appendMagnitude(buf[:0], amount), amount < 0, false)
+}
 
+// FormatFixed returns string of integer formatted as a plain decimal number, ignoring the currency's
+// separators: "." as decimal separator, no thousand separator and all decimal places, like "1234567.89".
+func (f *Formatter) FormatFixed(amount int64) string {
+	var buf [40]byte
+	return string(appendFixed(buf[:0], amount, f.Fraction))
+}
+
+// format formats the given absolute amount digits, adding the currency template if requested.
+// The result is written into a single preallocated buffer.
+func (f *Formatter) format(sa []byte, negative, template bool) string {
+	pad := 0
 	if len(sa) <= f.Fraction {
-		sa = strings.Repeat("0", f.Fraction-len(sa)+1) + sa
+		pad = f.Fraction - len(sa) + 1
 	}
 
+	intLen := len(sa) + pad - f.Fraction
+	groups := 0
 	if f.Thousand != "" {
-		for i := len(sa) - f.Fraction - 3; i > 0; i -= 3 {
-			sa = sa[:i] + f.Thousand + sa[i:]
-		}
+		groups = (intLen - 1) / 3
 	}
 
+	size := len(sa) + pad + groups*len(f.Thousand)
 	if f.Fraction > 0 {
-		sa = sa[:len(sa)-f.Fraction] + f.Decimal + sa[len(sa)-f.Fraction:]
+		size += len(f.Decimal)
 	}
-	sa = strings.Replace(f.Template, "1", sa, 1)
-	sa = strings.Replace(sa, "$", f.Grapheme, 1)
+	if negative {
+		size++
+	}
+
+	var l *layout
+	if template {
+		l = f.layout()
+		size += len(l.prefix) + len(l.suffix)
+	}
+
+	var b strings.Builder
+	b.Grow(size)
 
 	// Add minus sign for negative amount.
-	if amount < 0 {
-		sa = "-" + sa
+	if negative {
+		b.WriteByte('-')
+	}
+
+	if !template {
+		f.writeNumber(&b, sa, pad, intLen)
+		return b.String()
 	}
 
-	return sa
+	b.WriteString(l.prefix)
+	if l.number {
+		f.writeNumber(&b, sa, pad, intLen)
+	}
+	b.WriteString(l.suffix)
+
+	return b.String()
 }
 
-func (f *Formatter) FormatAmount(amount int64) string {
-	// Work with absolute amount value
-	sa := strconv.FormatInt(f.abs(amount), 10)
+// writeNumber writes the digits of sa, left padded with pad zeros, adding thousand and decimal separators.
+func (f *Formatter) writeNumber(b *strings.Builder, sa []byte, pad, intLen int) {
+	for i := 0; i < len(sa)+pad; i++ {
+		if i == intLen {
+			b.WriteString(f.Decimal)
+		} else if i > 0 && i < intLen && f.Thousand != "" && (intLen-i)%3 == 0 {
+			b.WriteString(f.Thousand)
+		}
 
-	if len(sa) <= f.Fraction {
-		sa = strings.Repeat("0", f.Fraction-len(sa)+1) + sa
+		if i < pad {
+			b.WriteByte('0')
+		} else {
+			b.WriteByte(sa[i-pad])
+		}
 	}
+}
 
-	if f.Thousand != "" {
-		for i := len(sa) - f.Fraction - 3; i > 0; i -= 3 {
-			sa = sa[:i] + f.Thousand + sa[i:]
-		}
+// maxLayouts bounds the number of cached layouts, as formatters may be created with arbitrary templates.
+const maxLayouts = 1024
+
+// layouts caches the layout of every template and grapheme pair used, so that rendering the same
+// currencies over and over doesn't parse their template every time.
+var layouts = struct {
+	sync.RWMutex
+	m map[layoutKey]*layout
+}{m: map[layoutKey]*layout{}}
+
+type layoutKey struct {
+	template string
+	grapheme string
+}
+
+// layout is a template split around the amount, with the grapheme already in place.
+type layout struct {
+	prefix string
+	suffix string
+	// number tells whether the template contains the amount at all.
+	number bool
+}
+
+// layout returns the cached layout of the formatter's template.
+func (f *Formatter) layout() *layout {
+	key := layoutKey{template: f.Template, grapheme: f.Grapheme}
+
+	layouts.RLock()
+	l, ok := layouts.m[key]
+	layouts.RUnlock()
+	if ok {
+		return l
 	}
 
-	if f.Fraction > 0 {
-		sa = sa[:len(sa)-f.Fraction] + f.Decimal + sa[len(sa)-f.Fraction:]
+	l = newLayout(f.Template, f.Grapheme)
+
+	layouts.Lock()
+	if len(layouts.m) < maxLayouts {
+		layouts.m[key] = l
 	}
+	layouts.Unlock()
 
-	// Add minus sign for negative amount.
+	return l
+}
+
+// newLayout splits template at its first "1", where the amount goes, replacing its first "$" with grapheme.
+func newLayout(template, grapheme string) *layout {
+	l := &layout{prefix: template}
+	if i := strings.Index(template, "1"); i != -1 {
+		l.prefix, l.suffix, l.number = template[:i], template[i+1:], true
+	}
+
+	if strings.Contains(l.prefix, "$") {
+		l.prefix = strings.Replace(l.prefix, "$", grapheme, 1)
+	} else {
+		l.suffix = strings.Replace(l.suffix, "$", grapheme, 1)
+	}
+
+	return l
+}
+
+// appendMagnitude appends the base 10 digits of the absolute value of amount to b.
+func appendMagnitude(b []byte, amount int64) []byte {
+	u := uint64(amount)
+	if amount < 0 {
+		u = -u
+	}
+
+	return strconv.AppendUint(b, u, 10)
+}
+
+// appendFixed appends amount as a fixed-point decimal number with fraction decimal places to b,
+// using "." as decimal separator and no thousand separator.
+func appendFixed(b []byte, amount int64, fraction int) []byte {
 	if amount < 0 {
-		sa = "-" + sa
+		b = append(b, '-')
+	}
+
+	var buf [20]byte
+	digits := appendMagnitude(buf[:0], amount)
+	if len(digits) <= fraction {
+		b = append(b, '0')
+	} else {
+		b = append(b, digits[:len(digits)-fraction]...)
+		digits = digits[len(digits)-fraction:]
+	}
+
+	if fraction > 0 {
+		b = append(b, '.')
+		for i := len(digits); i < fraction; i++ {
+			b = append(b, '0')
+		}
+		b = append(b, digits...)
 	}
 
-	return sa
+	return b
 }
 
 // ToMajorUnits returns float64 representing the value in sub units using the currency data
@@ -89,12 +219,3 @@ func (f *Formatter) ToMajorUnits(amount int64) float64 {
 
 	return float64(amount) / float64(math.Pow10(f.Fraction))
 }
-
-// abs return absolute value of given integer.
-func (f Formatter) abs(amount int64) int64 {
-	if amount < 0 {
-		return -amount
-	}
-
-	return amount
-}
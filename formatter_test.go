@@ -1,6 +1,7 @@
 package money
 
 import (
+	"math"
 	"testing"
 )
 
@@ -86,6 +87,66 @@ func TestFormatter_Format(t *testing.T) {
 	}
 }
 
+func TestFormatter_Format2(t *testing.T) {
+	tcs := []struct {
+		grapheme string
+		template string
+		amount   int64
+		expected string
+	}{
+		{"$", "$1", 123, "$1.23"},
+		{"$", "1$", -123, "-1.23$"},
+		{"1€", "$ 1", 123, "1€ 1.23"},
+		{"€", "1 $ $", 123, "1.23 € $"},
+		{"€", "$ 1 1", 123, "€ 1.23 1"},
+		{"€", "$", 123, "€"},
+		{"€", "", 123, ""},
+		{"$", "$1", math.MinInt64, "-$92,233,720,368,547,758.08"},
+	}
+
+	for _, tc := range tcs {
+		formatter := NewFormatter(2, ".", ",", tc.grapheme, tc.template)
+		for i := 0; i < 2; i++ {
+			if r := formatter.Format(tc.amount); r != tc.expected {
+				t.Errorf("Expected %d with template %q to be %q got %q", tc.amount, tc.template, tc.expected, r)
+			}
+		}
+	}
+}
+
+func TestFormatter_Format3(t *testing.T) {
+	before := len(layouts.m)
+	for i := 0; i < 3; i++ {
+		NewFormatter(2, ".", ",", "Q", "1 $ (cached)").Format(100)
+	}
+
+	if len(layouts.m) != before+1 {
+		t.Errorf("Expected one cached layout got %d", len(layouts.m)-before)
+	}
+}
+
+func TestFormatter_FormatFixed(t *testing.T) {
+	tcs := []struct {
+		fraction int
+		amount   int64
+		expected string
+	}{
+		{2, 123456789, "1234567.89"},
+		{2, -5, "-0.05"},
+		{2, 0, "0.00"},
+		{3, 1, "0.001"},
+		{0, -1000, "-1000"},
+		{2, math.MinInt64, "-92233720368547758.08"},
+	}
+
+	for _, tc := range tcs {
+		formatter := NewFormatter(tc.fraction, ",", ".", "€", "1 $")
+		if r := formatter.FormatFixed(tc.amount); r != tc.expected {
+			t.Errorf("Expected %d to be %q got %q", tc.amount, tc.expected, r)
+		}
+	}
+}
+
 func TestFormatter_FormatAmount(t *testing.T) {
 	tcs := []struct {
 		fraction int
@@ -0,0 +1,43 @@
+package money
+
+import (
+	"fmt"
+)
+
+// RealizedFX is the realized exchange gain or loss of an amount booked at one rate and settled at another.
+type RealizedFX struct {
+	// Booked is the amount converted at the rate of the original conversion, as recorded in the books.
+	Booked *Money
+	// Settled is the amount converted at the rate of the settlement.
+	Settled *Money
+	// GainLoss is Settled minus Booked, a gain when positive and a loss when negative.
+	GainLoss *Money
+}
+
+// RealizedFX returns the realized exchange gain or loss in the reporting currency of Self, booked at one rate
+// and settled at another, both from the currency of Self into the reporting currency. Both conversions are
+// rounded half away from zero, like they are booked. Self is positive for receivables; give payables as
+// negative amounts, so that paying more than booked is a loss.
+func (m *Money) RealizedFX(reportingCode string, booked, settled Rate) (*RealizedFX, error) {
+	currency := GetCurrency(reportingCode)
+	if currency == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", reportingCode)
+	}
+
+	b, _, err := m.convert(currency, RateTable{}.Set(m.CurrencyCode(), reportingCode, booked))
+	if err != nil {
+		return nil, err
+	}
+
+	s, _, err := m.convert(currency, RateTable{}.Set(m.CurrencyCode(), reportingCode, settled))
+	if err != nil {
+		return nil, err
+	}
+
+	gl, err := s.SubtractAll(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RealizedFX{Booked: b, Settled: s, GainLoss: gl}, nil
+}
@@ -0,0 +1,50 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestMoney_RealizedFX(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		booked   Rate
+		settled  Rate
+		expected []string
+	}{
+		{100000, USD, Rate{90, 100}, Rate{95, 100}, []string{"€900.00", "€950.00", "€50.00"}},
+		{100000, USD, Rate{95, 100}, Rate{90, 100}, []string{"€950.00", "€900.00", "-€50.00"}},
+		{-100000, USD, Rate{90, 100}, Rate{95, 100}, []string{"-€900.00", "-€950.00", "-€50.00"}},
+		{100000, JPY, Rate{61, 10000}, Rate{6123, 1000000}, []string{"€610.00", "€612.30", "€2.30"}},
+		{333, USD, Rate{1, 3}, Rate{1, 3}, []string{"€1.11", "€1.11", "€0.00"}},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		r, err := m.RealizedFX(EUR, tc.booked, tc.settled)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := []string{r.Booked.Display(), r.Settled.Display(), r.GainLoss.Display()}
+		for i := range got {
+			if got[i] != tc.expected[i] {
+				t.Errorf("Expected %v got %v", tc.expected, got)
+				break
+			}
+		}
+	}
+
+	m, _ := New(100, USD)
+	if _, err := m.RealizedFX("XXX", Rate{1, 1}, Rate{1, 1}); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+
+	if _, err := m.RealizedFX(EUR, Rate{}, Rate{1, 1}); err == nil {
+		t.Error("Expected error for zero rate")
+	}
+
+	if _, err := m.RealizedFX(EUR, Rate{1, 1}, Rate{-1, 1}); err == nil {
+		t.Error("Expected error for negative rate")
+	}
+}
@@ -0,0 +1,187 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// maxPathLegs is the most conversions ConvertPath chains, as the paths it compares grow exponentially with them.
+const maxPathLegs = 4
+
+// PathStrategy decides which conversion path ConvertPath picks when several connect two currencies.
+type PathStrategy int
+
+const (
+	// PathFewestLegs picks the path with the fewest conversions, then the one giving the highest amount.
+	PathFewestLegs PathStrategy = iota
+	// PathBestAmount picks the path giving the highest amount, then the one with the fewest conversions.
+	PathBestAmount
+)
+
+func (s PathStrategy) validate() error {
+	switch s {
+	case PathFewestLegs, PathBestAmount:
+		return nil
+	}
+
+	return errors.New("unknown path strategy")
+}
+
+// ConversionLeg is a single conversion of a ConversionPath.
+type ConversionLeg struct {
+	From *Money
+	To   *Money
+	Rate Rate
+}
+
+// ConversionPath is the outcome of ConvertPath.
+type ConversionPath struct {
+	// Legs are the conversions in order, each converting the result of the previous one.
+	Legs []ConversionLeg
+	// Result is the converted Money, the result of the last leg.
+	Result *Money
+}
+
+// ConvertPath converts Money to the given currency through the rates of the table, chaining conversions
+// through other currencies when there's no direct rate, e.g. from SEK to JPY through EUR and USD.
+// Every leg is rounded half away from zero like Convert, and paths are compared by the amounts they
+// actually give, rounding included. Paths have at most maxLegs conversions, up to 4, and never visit a
+// currency twice. Paths with a failing leg, e.g. one overflowing, are skipped, and their first error is
+// returned when no other path is left. Ties are broken by the alphabetical order of the currencies visited,
+// so the same table always gives the same path.
+//
+//	p, err := price.ConvertPath(money.JPY, rates, money.PathBestAmount, 3)
+func (m *Money) ConvertPath(currencyCode string, t RateTable, strategy PathStrategy, maxLegs int) (*ConversionPath, error) {
+	to := GetCurrency(currencyCode)
+	if to == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", currencyCode)
+	}
+
+	if err := strategy.validate(); err != nil {
+		return nil, err
+	}
+
+	if maxLegs < 1 || maxLegs > maxPathLegs {
+		return nil, fmt.Errorf("max legs must be between 1 and %d", maxPathLegs)
+	}
+
+	from := m.currency.get()
+	if from.Code == to.Code {
+		converted, _, err := m.convert(to, t)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ConversionPath{Result: converted}, nil
+	}
+
+	graph := t.graph()
+	visited := map[string]bool{from.Code: true}
+
+	var best *ConversionPath
+	var failed error
+	var walk func(cur *Money, legs []ConversionLeg, limit int)
+	walk = func(cur *Money, legs []ConversionLeg, limit int) {
+		code := cur.currency.get().Code
+		if code == to.Code {
+			if p := (&ConversionPath{Legs: legs, Result: cur}); p.better(best, strategy) {
+				best = p
+			}
+			return
+		}
+
+		if len(legs) == limit {
+			return
+		}
+
+		for _, next := range graph[code] {
+			currency := GetCurrency(next)
+			if visited[next] || currency == nil {
+				continue
+			}
+
+			converted, r, err := cur.convert(currency, t)
+			if err != nil {
+				if failed == nil {
+					failed = err
+				}
+				continue
+			}
+
+			visited[next] = true
+			walk(converted, append(legs[:len(legs):len(legs)], ConversionLeg{From: cur, To: converted, Rate: r}), limit)
+			visited[next] = false
+		}
+	}
+
+	// Searching with growing limits finds the fewest legs without walking the longer paths.
+	limit := 1
+	if strategy == PathBestAmount {
+		limit = maxLegs
+	}
+
+	for ; best == nil && limit <= maxLegs; limit++ {
+		walk(m, nil, limit)
+	}
+
+	if best == nil && failed != nil {
+		return nil, failed
+	}
+
+	if best == nil {
+		return nil, fmt.Errorf("%w from '%s' to '%s' within %d legs", ErrNoRate, from.Code, to.Code, maxLegs)
+	}
+
+	return best, nil
+}
+
+// better returns boolean of whether the path is better than the other, possibly nil, one.
+func (p *ConversionPath) better(other *ConversionPath, strategy PathStrategy) bool {
+	if other == nil {
+		return true
+	}
+
+	fewer, more := len(p.Legs) < len(other.Legs), len(p.Legs) > len(other.Legs)
+	higher, lower := p.Result.amount > other.Result.amount, p.Result.amount < other.Result.amount
+	if strategy == PathBestAmount {
+		return higher || !lower && fewer
+	}
+
+	return fewer || !more && higher
+}
+
+// graph returns the currencies every currency of the table converts to with a usable rate, sorted,
+// inverse rates included.
+func (t RateTable) graph() map[string][]string {
+	pairs := map[string]map[string]bool{}
+	link := func(from, to string) {
+		if r, err := t.Rate(from, to); err != nil || r.Numerator <= 0 || r.Denominator <= 0 || pairs[from][to] {
+			return
+		}
+
+		if pairs[from] == nil {
+			pairs[from] = map[string]bool{}
+		}
+		pairs[from][to] = true
+	}
+
+	for from, rates := range t {
+		for to := range rates {
+			if from != to {
+				link(from, to)
+				link(to, from)
+			}
+		}
+	}
+
+	graph := make(map[string][]string, len(pairs))
+	for from, tos := range pairs {
+		for to := range tos {
+			graph[from] = append(graph[from], to)
+		}
+		sort.Strings(graph[from])
+	}
+
+	return graph
+}
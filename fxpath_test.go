@@ -0,0 +1,120 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func pathRates() RateTable {
+	return RateTable{}.
+		Set(EUR, USD, Rate{11, 10}).
+		Set(USD, JPY, Rate{150, 1}).
+		Set(EUR, GBP, Rate{85, 100}).
+		Set(GBP, JPY, Rate{190, 1}).
+		Set(EUR, JPY, Rate{160, 1}).
+		Set(CHF, EUR, Rate{0, 1})
+}
+
+func TestMoney_ConvertPath(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		from     string
+		to       string
+		strategy PathStrategy
+		maxLegs  int
+		expected string
+		path     []string
+	}{
+		{10000, EUR, JPY, PathFewestLegs, 3, "¥16000", []string{EUR, JPY}},
+		{10000, EUR, JPY, PathBestAmount, 3, "¥16500", []string{EUR, USD, JPY}},
+		{10000, EUR, JPY, PathBestAmount, 1, "¥16000", []string{EUR, JPY}},
+		{16500, JPY, GBP, PathFewestLegs, 3, "£86.84", []string{JPY, GBP}},
+		{16500, JPY, GBP, PathBestAmount, 3, "£87.66", []string{JPY, EUR, GBP}},
+		{8500, GBP, USD, PathFewestLegs, 3, "$110.00", []string{GBP, EUR, USD}},
+		// Rounded every leg: £0.05 gives €0.0588, rounded to €0.06, giving $0.066 rounded to $0.07.
+		{5, GBP, USD, PathFewestLegs, 3, "$0.07", []string{GBP, EUR, USD}},
+		{1234, EUR, EUR, PathFewestLegs, 1, "€12.34", []string{EUR}},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.from)
+		p, err := m.ConvertPath(tc.to, pathRates(), tc.strategy, tc.maxLegs)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s to %s: %v", m.Display(), tc.to, err)
+		}
+
+		if p.Result.Display() != tc.expected {
+			t.Errorf("Expected %s to be %s got %s", m.Display(), tc.expected, p.Result.Display())
+		}
+
+		path := []string{tc.from}
+		prev := m
+		for _, leg := range p.Legs {
+			if leg.From != prev {
+				t.Errorf("Expected leg to convert %s got %s", prev.Display(), leg.From.Display())
+			}
+			path = append(path, leg.To.CurrencyCode())
+			prev = leg.To
+		}
+
+		if len(path) != len(tc.path) {
+			t.Errorf("Expected path %v got %v", tc.path, path)
+			continue
+		}
+
+		for i := range path {
+			if path[i] != tc.path[i] {
+				t.Errorf("Expected path %v got %v", tc.path, path)
+				break
+			}
+		}
+	}
+}
+
+func TestMoney_ConvertPath_Errors(t *testing.T) {
+	m, _ := New(100, CHF)
+	if _, err := m.ConvertPath(JPY, pathRates(), PathFewestLegs, 3); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected %v got %v", ErrNoRate, err)
+	}
+
+	m, _ = New(100, USD)
+	if _, err := m.ConvertPath(GBP, pathRates(), PathFewestLegs, 1); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected %v got %v", ErrNoRate, err)
+	}
+
+	if _, err := m.ConvertPath("XYZ", pathRates(), PathFewestLegs, 1); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+
+	if _, err := m.ConvertPath(GBP, pathRates(), PathStrategy(42), 1); err == nil {
+		t.Error("Expected error for unknown strategy")
+	}
+
+	if _, err := m.ConvertPath(GBP, pathRates(), PathFewestLegs, 0); err == nil {
+		t.Error("Expected error for no legs")
+	}
+
+	if _, err := m.ConvertPath(GBP, pathRates(), PathFewestLegs, 5); err == nil {
+		t.Error("Expected error for too many legs")
+	}
+}
+
+func TestMoney_ConvertPath_FailingLeg(t *testing.T) {
+	rates := RateTable{}.
+		Set(EUR, USD, Rate{1000, 1}).
+		Set(EUR, GBP, Rate{1, 1}).
+		Set(GBP, USD, Rate{1, 1})
+
+	m, _ := New(math.MaxInt64/100, EUR)
+	if _, err := m.ConvertPath(USD, rates, PathFewestLegs, 1); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	for _, strategy := range []PathStrategy{PathFewestLegs, PathBestAmount} {
+		p, err := m.ConvertPath(USD, rates, strategy, 2)
+		if err != nil || len(p.Legs) != 2 || p.Result.amount != math.MaxInt64/100 {
+			t.Errorf("Expected the path through GBP got %v, %v", p, err)
+		}
+	}
+}
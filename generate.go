@@ -0,0 +1,44 @@
+package money
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+)
+
+// boundaryAmounts are the amounts most likely to break arithmetic, generated more often than others.
+var boundaryAmounts = []Amount{0, 1, -1, math.MaxInt64, math.MinInt64, math.MaxInt64 - 1, math.MinInt64 + 1}
+
+// Generate is implementation of testing/quick's Generator, returning *Money of a random listed currency.
+// Amounts spread evenly over their number of digits, so small and huge amounts are as likely, and one in
+// eight amounts is a boundary value like zero, one or the extremes of int64. Use *Money in property functions,
+// Money values can't be generated.
+func (m *Money) Generate(r *rand.Rand, size int) reflect.Value {
+	codes := make([]string, 0, len(currencies))
+	for code := range currencies {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	return reflect.ValueOf(&Money{amount: randomAmount(r), currency: currencies[codes[r.Intn(len(codes))]]})
+}
+
+// randomAmount returns a random amount as described by Generate.
+func randomAmount(r *rand.Rand) Amount {
+	if r.Intn(8) == 0 {
+		return boundaryAmounts[r.Intn(len(boundaryAmounts))]
+	}
+
+	max := int64(10)
+	for digits := r.Intn(18); digits > 0; digits-- {
+		max *= 10
+	}
+
+	a := r.Int63n(max)
+	if r.Intn(2) == 0 {
+		return -a
+	}
+
+	return a
+}
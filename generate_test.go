@@ -0,0 +1,57 @@
+package money
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+func TestMoney_Generate(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	var boundaries, small, large, negative int
+	codes := map[string]bool{}
+	for i := 0; i < 1000; i++ {
+		m := (&Money{}).Generate(r, 0).Interface().(*Money)
+		if GetCurrency(m.CurrencyCode()) == nil {
+			t.Fatalf("Expected listed currency got %s", m.CurrencyCode())
+		}
+		codes[m.CurrencyCode()] = true
+
+		a := m.amount
+		switch {
+		case a == 0 || a == math.MaxInt64 || a == math.MinInt64:
+			boundaries++
+		case a > -1000 && a < 1000:
+			small++
+		case a > 1e15 || a < -1e15:
+			large++
+		}
+
+		if a < 0 {
+			negative++
+		}
+	}
+
+	if boundaries < 20 || small < 50 || large < 50 || negative < 300 || len(codes) < 50 {
+		t.Errorf("Expected well distributed amounts got %d boundaries, %d small, %d large, %d negative in %d currencies",
+			boundaries, small, large, negative, len(codes))
+	}
+}
+
+func TestMoney_Generate2(t *testing.T) {
+	negate := func(m *Money) bool {
+		return m.amount == math.MinInt64 || m.Negative().Absolute().Negative().amount == -m.Absolute().amount
+	}
+
+	if err := quick.Check(negate, &quick.Config{Rand: rand.New(rand.NewSource(1))}); err != nil {
+		t.Error(err)
+	}
+
+	// The same seed generates the same Money.
+	a := (&Money{}).Generate(rand.New(rand.NewSource(7)), 0).Interface().(*Money)
+	b := (&Money{}).Generate(rand.New(rand.NewSource(7)), 0).Interface().(*Money)
+	if *a != *b {
+		t.Errorf("Expected %v to == %v", a, b)
+	}
+}
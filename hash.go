@@ -0,0 +1,29 @@
+package money
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+)
+
+// Hash returns a stable 64-bit hash of Money, suitable for deduplication, sharding and cache keys.
+//
+// The algorithm is guaranteed not to change between releases: it's the 64-bit FNV-1a hash of
+// the currency code as registered, followed by a single zero byte, followed by the number of decimal places
+// the amount is counted with as a single byte, followed by the amount as 8 bytes big-endian two's complement.
+// The same amount in micros and in the currency's smallest unit hash differently, like they compare.
+// Zero value Money hashes like an empty currency code with the default 2 decimal places.
+func (m *Money) Hash() uint64 {
+	c := m.currency.get()
+
+	h := fnv.New64a()
+	b := make([]byte, 0, len(c.Code)+10)
+	b = append(b, c.Code...)
+	b = append(b, 0, byte(c.Fraction))
+
+	var a [8]byte
+	binary.BigEndian.PutUint64(a[:], uint64(m.amount))
+	b = append(b, a[:]...)
+
+	_, _ = h.Write(b)
+	return h.Sum64()
+}
@@ -0,0 +1,62 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestMoney_Hash(t *testing.T) {
+	// Hashes must never change, they may be persisted by users.
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected uint64
+	}{
+		{0, EUR, 13492835412599261925},
+		{100, EUR, 13492936567669057337},
+		{-100, EUR, 6772234322570196132},
+		{100, USD, 18090835305566956281},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		if h := m.Hash(); h != tc.expected {
+			t.Errorf("Expected hash of %d %s to be %d got %d", tc.amount, tc.code, tc.expected, h)
+		}
+	}
+}
+
+func TestMoney_Hash_Fraction(t *testing.T) {
+	micros, _ := NewFromMicros(100000000, USD)
+	if h := micros.Hash(); h != 13626162380245295506 {
+		t.Errorf("Expected hash of micros to be %d got %d", uint64(13626162380245295506), h)
+	}
+
+	cents, _ := New(100000000, USD)
+	if micros.Hash() == cents.Hash() {
+		t.Errorf("Expected %s and %s to hash differently", micros.Display(), cents.Display())
+	}
+}
+
+func TestMoney_Hash_CustomCodes(t *testing.T) {
+	defer Restore(Snapshot())
+
+	AddCurrency("xlc", "L", "1 $", ".", ",", 2)
+	AddCurrency("XLC", "L", "1 $", ".", ",", 2)
+	lower, _ := New(1, "xlc")
+	upper, _ := New(1, "XLC")
+
+	if h := lower.Hash(); h != 13436988925937168179 {
+		t.Errorf("Expected hash of %s to be %d got %d", lower.CurrencyCode(), uint64(13436988925937168179), h)
+	}
+
+	if h := upper.Hash(); h != 12967990167650934035 {
+		t.Errorf("Expected hash of %s to be %d got %d", upper.CurrencyCode(), uint64(12967990167650934035), h)
+	}
+}
+
+func TestMoney_Hash_Zero(t *testing.T) {
+	var zero Money
+	if h := zero.Hash(); h != 4827398178045231975 {
+		t.Errorf("Expected hash of zero value to be %d got %d", uint64(4827398178045231975), h)
+	}
+}
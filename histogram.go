@@ -0,0 +1,86 @@
+package money
+
+import (
+	"errors"
+	"sort"
+)
+
+// Bucket is a range of a Histogram, from Min inclusive to Max exclusive, with the number and sum of
+// the amounts in it. The first bucket has no Min and the last no Max.
+type Bucket struct {
+	Min   *Money
+	Max   *Money
+	Count int
+	Sum   *Money
+}
+
+// Histogram counts and sums amounts in buckets delimited by Money boundaries, e.g. for fraud-scoring
+// features or pricing analytics:
+//
+//	h, _ := money.NewHistogram(tenEuros, hundredEuros)
+//	// buckets: below €10.00, €10.00 up to €100.00, and €100.00 or more
+type Histogram struct {
+	buckets []Bucket
+}
+
+// NewHistogram creates and returns new empty Histogram with buckets delimited by the given boundaries,
+// which must be in one currency and strictly ascending.
+func NewHistogram(bounds ...*Money) (*Histogram, error) {
+	if len(bounds) == 0 {
+		return nil, errors.New("histogram needs at least one boundary")
+	}
+
+	zero := bounds[0].WithAmount(0)
+	h := &Histogram{buckets: make([]Bucket, len(bounds)+1)}
+	for i, b := range bounds {
+		if err := zero.assertSameCurrency(b); err != nil {
+			return nil, err
+		}
+
+		if i > 0 && b.compare(bounds[i-1]) <= 0 {
+			return nil, errors.New("histogram boundaries must be strictly ascending")
+		}
+
+		h.buckets[i].Max = b
+		h.buckets[i+1].Min = b
+	}
+
+	for i := range h.buckets {
+		h.buckets[i].Sum = zero
+	}
+
+	return h, nil
+}
+
+// Bucket returns the index of the bucket m falls into.
+func (h *Histogram) Bucket(m *Money) (int, error) {
+	if err := h.buckets[0].Sum.assertSameCurrency(m); err != nil {
+		return 0, err
+	}
+
+	return sort.Search(len(h.buckets)-1, func(i int) bool {
+		return m.compare(h.buckets[i].Max) < 0
+	}), nil
+}
+
+// Add counts m in its bucket and adds it to the bucket's sum, failing with ErrOverflow instead of wrapping around.
+func (h *Histogram) Add(m *Money) error {
+	i, err := h.Bucket(m)
+	if err != nil {
+		return err
+	}
+
+	sum, err := h.buckets[i].Sum.AddAll(m)
+	if err != nil {
+		return err
+	}
+
+	h.buckets[i].Count++
+	h.buckets[i].Sum = sum
+	return nil
+}
+
+// Buckets returns the buckets in ascending order.
+func (h *Histogram) Buckets() []Bucket {
+	return h.buckets
+}
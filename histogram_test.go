@@ -0,0 +1,92 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestHistogram(t *testing.T) {
+	ten, _ := New(1000, EUR)
+	hundred, _ := New(10000, EUR)
+	h, err := NewHistogram(ten, hundred)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcs := []struct {
+		amount int64
+		bucket int
+	}{
+		{-500, 0},
+		{999, 0},
+		{1000, 1},
+		{5000, 1},
+		{9999, 1},
+		{10000, 2},
+		{250000, 2},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		if i, err := h.Bucket(m); err != nil || i != tc.bucket {
+			t.Errorf("Expected %d to fall into bucket %d got %d, %v", tc.amount, tc.bucket, i, err)
+		}
+
+		if err := h.Add(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := []struct {
+		min, max string
+		count    int
+		sum      string
+	}{
+		{"", "€10.00", 2, "€4.99"},
+		{"€10.00", "€100.00", 3, "€159.99"},
+		{"€100.00", "", 2, "€2600.00"},
+	}
+
+	bs := h.Buckets()
+	if len(bs) != len(expected) {
+		t.Fatalf("Expected %d buckets got %d", len(expected), len(bs))
+	}
+
+	display := func(m *Money) string {
+		if m == nil {
+			return ""
+		}
+		return m.Display()
+	}
+
+	for i, e := range expected {
+		b := bs[i]
+		if display(b.Min) != e.min || display(b.Max) != e.max || b.Count != e.count || b.Sum.Display() != e.sum {
+			t.Errorf("Expected bucket %d to be %+v got %s-%s, %d, %s", i, e, display(b.Min), display(b.Max), b.Count, b.Sum.Display())
+		}
+	}
+}
+
+func TestHistogram_Errors(t *testing.T) {
+	ten, _ := New(1000, EUR)
+	hundred, _ := New(10000, EUR)
+	usd, _ := New(1000, USD)
+
+	for _, bounds := range [][]*Money{nil, {hundred, ten}, {ten, ten}, {ten, usd}} {
+		if _, err := NewHistogram(bounds...); err == nil {
+			t.Errorf("Expected error for boundaries %v", bounds)
+		}
+	}
+
+	h, _ := NewHistogram(ten)
+	if err := h.Add(usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	max, _ := New(math.MaxInt64, EUR)
+	_ = h.Add(max)
+	if err := h.Add(max); !errors.Is(err, ErrOverflow) || h.Buckets()[1].Count != 1 {
+		t.Errorf("Expected %v leaving the bucket untouched got %v", ErrOverflow, err)
+	}
+}
@@ -0,0 +1,172 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Interpolation decides how HistoricalRates finds a rate for a date without one, like a weekend or a holiday.
+type Interpolation int
+
+const (
+	// InterpolateNone only uses rates of the exact date.
+	InterpolateNone Interpolation = iota
+	// InterpolatePrevious uses the rate of the closest earlier date, i.e. the previous business day.
+	InterpolatePrevious
+	// InterpolateLinear interpolates linearly between the closest earlier and later dates,
+	// falling back to the earlier one when there is no later rate yet.
+	InterpolateLinear
+)
+
+func (i Interpolation) String() string {
+	switch i {
+	case InterpolateNone:
+		return "none"
+	case InterpolatePrevious:
+		return "previous"
+	case InterpolateLinear:
+		return "linear"
+	}
+
+	return fmt.Sprintf("Interpolation(%d)", int(i))
+}
+
+// defaultLookback is the number of days HistoricalRates looks around a date by default, enough for long holidays.
+const defaultLookback = 7
+
+// HistoricalRates provides exchange rates by date, interpolating missing dates according to Policy
+// within Lookback days, or a week if it's zero.
+type HistoricalRates struct {
+	Policy   Interpolation
+	Lookback int
+
+	tables map[time.Time]RateTable
+}
+
+// RateSource tells where a historical rate comes from: the dates it was taken from and the policy applied,
+// InterpolateNone for a rate of the exact date.
+type RateSource struct {
+	Policy Interpolation
+	From   time.Time
+	To     time.Time
+}
+
+// day returns the date of t at midnight UTC, so that rates are looked up by calendar date.
+func day(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// Set adds the exchange rate from one currency to another on the given date.
+func (h *HistoricalRates) Set(date time.Time, from, to string, r Rate) *HistoricalRates {
+	if h.tables == nil {
+		h.tables = map[time.Time]RateTable{}
+	}
+
+	d := day(date)
+	if h.tables[d] == nil {
+		h.tables[d] = RateTable{}
+	}
+
+	h.tables[d].Set(from, to, r)
+	return h
+}
+
+// RateAt returns the exchange rate from one currency to another on the given date, along with its source.
+func (h *HistoricalRates) RateAt(date time.Time, from, to string) (Rate, RateSource, error) {
+	d := day(date)
+	if r, err := h.tables[d].Rate(from, to); err == nil {
+		return r, RateSource{Policy: InterpolateNone, From: d, To: d}, nil
+	}
+
+	lookback := h.Lookback
+	if lookback == 0 {
+		lookback = defaultLookback
+	}
+
+	if h.Policy == InterpolatePrevious || h.Policy == InterpolateLinear {
+		if pd, pr, ok := h.closest(d, from, to, -1, lookback); ok {
+			if h.Policy == InterpolateLinear {
+				if nd, nr, ok := h.closest(d, from, to, 1, lookback); ok {
+					return interpolate(pr, nr, d.Sub(pd), nd.Sub(pd)), RateSource{Policy: InterpolateLinear, From: pd, To: nd}, nil
+				}
+			}
+
+			return pr, RateSource{Policy: InterpolatePrevious, From: pd, To: pd}, nil
+		}
+	}
+
+	return Rate{}, RateSource{}, fmt.Errorf("%w from '%s' to '%s' on %s", ErrNoRate, from, to, d.Format("2006-01-02"))
+}
+
+// closest returns the closest date in direction dir with a rate from one currency to another within lookback days.
+func (h *HistoricalRates) closest(d time.Time, from, to string, dir, lookback int) (time.Time, Rate, bool) {
+	for i := 1; i <= lookback; i++ {
+		c := d.AddDate(0, 0, dir*i)
+		if r, err := h.tables[c].Rate(from, to); err == nil {
+			return c, r, true
+		}
+	}
+
+	return time.Time{}, Rate{}, false
+}
+
+// interpolate returns the rate at elapsed of span between a and b. Rates whose exact fraction doesn't fit
+// are rounded to 9 decimal places.
+func interpolate(a, b Rate, elapsed, span time.Duration) Rate {
+	ra, rb := big.NewRat(a.Numerator, a.Denominator), big.NewRat(b.Numerator, b.Denominator)
+	r := new(big.Rat).Sub(rb, ra)
+	r.Mul(r, big.NewRat(int64(elapsed), int64(span)))
+	r.Add(r, ra)
+
+	if r.Num().IsInt64() && r.Denom().IsInt64() {
+		return Rate{Numerator: r.Num().Int64(), Denominator: r.Denom().Int64()}
+	}
+
+	n, _ := roundRat(r.Mul(r, new(big.Rat).SetInt(pow10(9))), RoundHalfEven)
+	return Rate{Numerator: n, Denominator: 1000000000}
+}
+
+// At returns a Converter providing the rates on the given date.
+func (h *HistoricalRates) At(date time.Time) Converter {
+	return historicalConverter{h: h, date: date}
+}
+
+type historicalConverter struct {
+	h    *HistoricalRates
+	date time.Time
+}
+
+func (c historicalConverter) Rate(from, to string) (Rate, error) {
+	r, _, err := c.h.RateAt(c.date, from, to)
+	return r, err
+}
+
+// HistoricalConversion is the result of ConvertAt, recording the rate applied and where it comes from.
+type HistoricalConversion struct {
+	Money  *Money
+	Rate   Rate
+	Source RateSource
+}
+
+// ConvertAt converts Money to the given currency at the rate of the given date, like Convert does,
+// recording whether and how the rate was interpolated.
+func (m *Money) ConvertAt(currencyCode string, h *HistoricalRates, date time.Time) (*HistoricalConversion, error) {
+	currency := GetCurrency(currencyCode)
+	if currency == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", currencyCode)
+	}
+
+	r, src, err := h.RateAt(date, m.currency.get().Code, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	c, _, err := m.convert(currency, RateTable{}.Set(m.currency.get().Code, currencyCode, r))
+	if err != nil {
+		return nil, err
+	}
+
+	return &HistoricalConversion{Money: c, Rate: r, Source: src}, nil
+}
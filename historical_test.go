@@ -0,0 +1,109 @@
+package money
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func historical(policy Interpolation) *HistoricalRates {
+	h := &HistoricalRates{Policy: policy}
+	// Friday and Monday, with nothing over the weekend.
+	h.Set(date(2024, 3, 1), EUR, USD, Rate{108, 100})
+	h.Set(date(2024, 3, 4), EUR, USD, Rate{111, 100})
+	return h
+}
+
+func TestHistoricalRates_RateAt(t *testing.T) {
+	tcs := []struct {
+		policy   Interpolation
+		date     time.Time
+		expected Rate
+		source   RateSource
+	}{
+		{InterpolateNone, date(2024, 3, 1), Rate{108, 100}, RateSource{InterpolateNone, date(2024, 3, 1), date(2024, 3, 1)}},
+		{InterpolatePrevious, date(2024, 3, 1).Add(15 * time.Hour), Rate{108, 100}, RateSource{InterpolateNone, date(2024, 3, 1), date(2024, 3, 1)}},
+		{InterpolatePrevious, date(2024, 3, 3), Rate{108, 100}, RateSource{InterpolatePrevious, date(2024, 3, 1), date(2024, 3, 1)}},
+		{InterpolateLinear, date(2024, 3, 2), Rate{109, 100}, RateSource{InterpolateLinear, date(2024, 3, 1), date(2024, 3, 4)}},
+		{InterpolateLinear, date(2024, 3, 3), Rate{11, 10}, RateSource{InterpolateLinear, date(2024, 3, 1), date(2024, 3, 4)}},
+		{InterpolateLinear, date(2024, 3, 6), Rate{111, 100}, RateSource{InterpolatePrevious, date(2024, 3, 4), date(2024, 3, 4)}},
+	}
+
+	for _, tc := range tcs {
+		r, src, err := historical(tc.policy).RateAt(tc.date, EUR, USD)
+		if err != nil {
+			t.Errorf("Unexpected error for %v on %s: %v", tc.policy, tc.date, err)
+			continue
+		}
+
+		if r.normalize() != tc.expected.normalize() || src != tc.source {
+			t.Errorf("Expected %v on %s to give %v from %+v got %v from %+v", tc.policy, tc.date, tc.expected, tc.source, r, src)
+		}
+	}
+
+	// Inverse rates are interpolated in the requested direction, 100/108 + (100/111 - 100/108) / 3.
+	if r, _, err := historical(InterpolateLinear).RateAt(date(2024, 3, 2), USD, EUR); err != nil || r != (Rate{2750, 2997}) {
+		t.Errorf("Expected %v got %v, %v", Rate{2750, 2997}, r, err)
+	}
+}
+
+func TestHistoricalRates_Errors(t *testing.T) {
+	tcs := []struct {
+		h    *HistoricalRates
+		date time.Time
+		to   string
+	}{
+		{historical(InterpolateNone), date(2024, 3, 2), USD},
+		{historical(InterpolatePrevious), date(2024, 3, 2), GBP},
+		{historical(InterpolatePrevious), date(2024, 3, 29), USD},
+		{historical(InterpolateLinear), date(2024, 3, 1).AddDate(0, 0, -1), USD},
+		{&HistoricalRates{Policy: InterpolatePrevious, Lookback: 1}, date(2024, 3, 3), USD},
+	}
+
+	tcs[4].h.Set(date(2024, 3, 1), EUR, USD, Rate{1, 1})
+
+	for _, tc := range tcs {
+		if _, _, err := tc.h.RateAt(tc.date, EUR, tc.to); !errors.Is(err, ErrNoRate) {
+			t.Errorf("Expected %v for %v on %s got %v", ErrNoRate, tc.h.Policy, tc.date, err)
+		}
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	r := interpolate(Rate{1, 3}, Rate{1, 7}, 1, 3)
+	if r != (Rate{17, 63}) {
+		t.Errorf("Expected %v got %v", Rate{17, 63}, r)
+	}
+
+	// Fractions that don't fit are rounded to 9 decimal places.
+	r = interpolate(Rate{1, 999999937}, Rate{1, 999999929}, 1, 999999893)
+	if r.Denominator != 1000000000 || r.Numerator != 1 {
+		t.Errorf("Expected %v got %v", Rate{1, 1000000000}, r)
+	}
+}
+
+func TestMoney_ConvertAt(t *testing.T) {
+	h := historical(InterpolateLinear)
+	m, _ := New(10000, EUR)
+
+	c, err := m.ConvertAt(USD, h, date(2024, 3, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Money.Display() != "$110.00" || c.Source.Policy != InterpolateLinear {
+		t.Errorf("Expected %s by %v got %s by %v", "$110.00", InterpolateLinear, c.Money.Display(), c.Source.Policy)
+	}
+
+	if c, _, err := m.Convert(USD, h.At(date(2024, 3, 2))); err != nil || c.Display() != "$109.00" {
+		t.Errorf("Expected %s got %v, %v", "$109.00", c, err)
+	}
+
+	if _, err := m.ConvertAt("XXX", h, date(2024, 3, 1)); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+
+	if _, err := m.ConvertAt(GBP, h, date(2024, 3, 1)); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected %v got %v", ErrNoRate, err)
+	}
+}
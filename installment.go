@@ -0,0 +1,68 @@
+package money
+
+import (
+	"errors"
+	"time"
+)
+
+// Interval describes the gap between two consecutive installments.
+type Interval struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+// Installment is a single dated payment of a schedule.
+type Installment struct {
+	Number  int
+	DueDate time.Time
+	Amount  *Money
+}
+
+// SplitIntoInstallments returns a schedule of n payments due every interval starting from start.
+// Leftover pennies are distributed according to strategy, so unlike Split they can be given
+// entirely to the first or the last installment.
+//
+// Intervals counted in whole months or years keep the day of month of start and are clamped
+// to the end of shorter months, e.g. monthly installments starting on January 31st fall due on
+// February 28th (or 29th), March 31st, April 30th and so on.
+func (m *Money) SplitIntoInstallments(n int, strategy RemainderStrategy, start time.Time, interval Interval) ([]*Installment, error) {
+	if n <= 0 {
+		return nil, errors.New("number of installments must be higher than zero")
+	}
+
+	if interval == (Interval{}) {
+		return nil, errors.New("installment interval must not be empty")
+	}
+
+	as, err := mutate.calc.distribute(m.amount, n, strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	is := make([]*Installment, n)
+	for i, a := range as {
+		is[i] = &Installment{
+			Number:  i + 1,
+			DueDate: interval.after(start, i),
+			Amount:  &Money{amount: a, currency: m.currency},
+		}
+	}
+
+	return is, nil
+}
+
+// after returns the date n intervals after t.
+func (iv Interval) after(t time.Time, n int) time.Time {
+	if iv.Days != 0 {
+		return t.AddDate(n*iv.Years, n*iv.Months, n*iv.Days)
+	}
+
+	y, mo, d := t.Date()
+	first := time.Date(y+n*iv.Years, mo+time.Month(n*iv.Months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); d > last {
+		d = last
+	}
+
+	return first.AddDate(0, 0, d-1)
+}
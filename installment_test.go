@@ -0,0 +1,89 @@
+package money
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMoney_SplitIntoInstallments(t *testing.T) {
+	start := time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC)
+	tcs := []struct {
+		amount   int64
+		n        int
+		strategy RemainderStrategy
+		expected []int64
+	}{
+		{100, 3, RemainderFirst, []int64{34, 33, 33}},
+		{100, 3, RemainderLast, []int64{33, 33, 34}},
+		{1000, 6, RemainderFirst, []int64{170, 166, 166, 166, 166, 166}},
+		{1000, 6, RemainderLast, []int64{166, 166, 166, 166, 166, 170}},
+		{-100, 3, RemainderFirst, []int64{-34, -33, -33}},
+		{99, 3, RemainderLast, []int64{33, 33, 33}},
+		{101, 3, RemainderRoundRobin, []int64{34, 34, 33}},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		is, err := m.SplitIntoInstallments(tc.n, tc.strategy, start, Interval{Months: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, in := range is {
+			if in.Number != i+1 {
+				t.Errorf("Expected installment number %d got %d", i+1, in.Number)
+			}
+
+			if in.Amount.amount != tc.expected[i] {
+				t.Errorf("Expected installment %d of %d to be %d got %d", i+1, tc.amount, tc.expected[i], in.Amount.amount)
+			}
+
+			if in.Amount.currency.Code != EUR {
+				t.Errorf("Expected currency %s got %s", EUR, in.Amount.currency.Code)
+			}
+		}
+	}
+}
+
+func TestMoney_SplitIntoInstallments_DueDates(t *testing.T) {
+	start := time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC)
+	tcs := []struct {
+		interval Interval
+		expected []string
+	}{
+		{Interval{Months: 1}, []string{"2024-01-31", "2024-02-29", "2024-03-31", "2024-04-30"}},
+		{Interval{Years: 1}, []string{"2024-01-31", "2025-01-31", "2026-01-31", "2027-01-31"}},
+		{Interval{Days: 14}, []string{"2024-01-31", "2024-02-14", "2024-02-28", "2024-03-13"}},
+	}
+
+	m, _ := New(1000, EUR)
+	for _, tc := range tcs {
+		is, err := m.SplitIntoInstallments(len(tc.expected), RemainderFirst, start, tc.interval)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, in := range is {
+			if d := in.DueDate.Format("2006-01-02"); d != tc.expected[i] {
+				t.Errorf("Expected installment %d due %s got %s", i+1, tc.expected[i], d)
+			}
+		}
+	}
+}
+
+func TestMoney_SplitIntoInstallments2(t *testing.T) {
+	m, _ := New(100, EUR)
+	start := time.Now()
+
+	if _, err := m.SplitIntoInstallments(0, RemainderFirst, start, Interval{Months: 1}); err == nil {
+		t.Error("Expected error for zero installments")
+	}
+
+	if _, err := m.SplitIntoInstallments(3, RemainderFirst, start, Interval{}); err == nil {
+		t.Error("Expected error for empty interval")
+	}
+
+	if _, err := m.SplitIntoInstallments(3, RemainderStrategy(42), start, Interval{Months: 1}); err == nil {
+		t.Error("Expected error for unknown strategy")
+	}
+}
@@ -0,0 +1,221 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// Int128 is a signed 128-bit integer stored as two's complement in two 64-bit words.
+// It's meant for amounts which may overflow int64, like sums of 18 decimal amounts,
+// while being far cheaper than math/big. Like int64, it silently wraps around on overflow.
+type Int128 struct {
+	hi uint64
+	lo uint64
+}
+
+var (
+	minInt128      = Int128{hi: 1 << 63}
+	maxInt128Div10 = Int128{hi: math.MaxInt64 / 10, lo: 0xcccccccccccccccc}
+)
+
+// NewInt128 creates and returns new Int128 from an int64.
+func NewInt128(v int64) Int128 {
+	var hi uint64
+	if v < 0 {
+		hi = math.MaxUint64
+	}
+
+	return Int128{hi: hi, lo: uint64(v)}
+}
+
+// ParseInt128 parses a base 10 string, with an optional leading sign, into an Int128.
+func ParseInt128(s string) (Int128, error) {
+	digits := strings.TrimPrefix(strings.TrimPrefix(s, "+"), "-")
+	negative := strings.HasPrefix(s, "-")
+	if digits == "" || len(s)-len(digits) > 1 {
+		return Int128{}, fmt.Errorf("invalid int128 '%s'", s)
+	}
+
+	var r Int128
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return Int128{}, fmt.Errorf("invalid int128 '%s'", s)
+		}
+
+		if r.Cmp(maxInt128Div10) > 0 {
+			return Int128{}, fmt.Errorf("int128 '%s' out of range", s)
+		}
+
+		// Only -2^127 may still have the sign bit set here.
+		r = r.Mul64(10).Add(NewInt128(int64(c - '0')))
+		if r.Sign() < 0 && (!negative || r != minInt128) {
+			return Int128{}, fmt.Errorf("int128 '%s' out of range", s)
+		}
+	}
+
+	if negative {
+		r = r.Neg()
+	}
+
+	return r, nil
+}
+
+// Add returns a + b.
+func (a Int128) Add(b Int128) Int128 {
+	lo, carry := bits.Add64(a.lo, b.lo, 0)
+	hi, _ := bits.Add64(a.hi, b.hi, carry)
+	return Int128{hi: hi, lo: lo}
+}
+
+// Sub returns a - b.
+func (a Int128) Sub(b Int128) Int128 {
+	lo, borrow := bits.Sub64(a.lo, b.lo, 0)
+	hi, _ := bits.Sub64(a.hi, b.hi, borrow)
+	return Int128{hi: hi, lo: lo}
+}
+
+// Neg returns -a.
+func (a Int128) Neg() Int128 {
+	return Int128{}.Sub(a)
+}
+
+// Abs returns the absolute value of a.
+func (a Int128) Abs() Int128 {
+	if a.Sign() < 0 {
+		return a.Neg()
+	}
+
+	return a
+}
+
+// Mul64 returns a * m.
+func (a Int128) Mul64(m int64) Int128 {
+	negative := m < 0
+	if negative {
+		m = -m
+	}
+
+	hi, lo := bits.Mul64(a.lo, uint64(m))
+	r := Int128{hi: hi + a.hi*uint64(m), lo: lo}
+
+	if negative {
+		return r.Neg()
+	}
+
+	return r
+}
+
+// QuoRem64 returns the quotient and the remainder of a divided by d, truncated towards zero like int64 does.
+func (a Int128) QuoRem64(d int64) (Int128, int64) {
+	if d == 0 {
+		panic("division by zero")
+	}
+
+	ua, ud := a.Abs(), uint64(d)
+	if d < 0 {
+		ud = uint64(-d)
+	}
+
+	hi, r := bits.Div64(0, ua.hi, ud)
+	lo, r := bits.Div64(r, ua.lo, ud)
+	q, rem := Int128{hi: hi, lo: lo}, int64(r)
+
+	if (a.Sign() < 0) != (d < 0) {
+		q = q.Neg()
+	}
+
+	if a.Sign() < 0 {
+		rem = -rem
+	}
+
+	return q, rem
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of a.
+func (a Int128) Sign() int {
+	switch {
+	case a.hi>>63 == 1:
+		return -1
+	case a.hi == 0 && a.lo == 0:
+		return 0
+	}
+
+	return 1
+}
+
+// Cmp compares a and b and returns -1, 0 or 1.
+func (a Int128) Cmp(b Int128) int {
+	switch {
+	case int64(a.hi) < int64(b.hi):
+		return -1
+	case int64(a.hi) > int64(b.hi):
+		return 1
+	case a.lo < b.lo:
+		return -1
+	case a.lo > b.lo:
+		return 1
+	}
+
+	return 0
+}
+
+// Int64 returns a as an int64 and whether it fits into one.
+func (a Int128) Int64() (int64, bool) {
+	v := int64(a.lo)
+	return v, NewInt128(v) == a
+}
+
+// String returns the base 10 representation of a.
+func (a Int128) String() string {
+	if a.Sign() < 0 {
+		return "-" + a.digits()
+	}
+
+	return a.digits()
+}
+
+// bigInt returns a as a big.Int.
+func (a Int128) bigInt() *big.Int {
+	// The magnitude is treated as unsigned, so that -2^127 is converted correctly.
+	v := a
+	if a.Sign() < 0 {
+		v = a.Neg()
+	}
+
+	b := new(big.Int).SetUint64(v.hi)
+	b.Lsh(b, 64).Or(b, new(big.Int).SetUint64(v.lo))
+	if a.Sign() < 0 {
+		b.Neg(b)
+	}
+
+	return b
+}
+
+// digits returns the base 10 representation of the absolute value of a.
+func (a Int128) digits() string {
+	// The magnitude is treated as unsigned, so that -2^127 is printed correctly.
+	v := a
+	if a.Sign() < 0 {
+		v = a.Neg()
+	}
+
+	const chunk = 1e18
+	var parts []string
+	for v.hi != 0 {
+		hi, r := bits.Div64(0, v.hi, chunk)
+		lo, r := bits.Div64(r, v.lo, chunk)
+		v = Int128{hi: hi, lo: lo}
+		parts = append(parts, strconv.FormatUint(r, 10))
+	}
+
+	s := strconv.FormatUint(v.lo, 10)
+	for i := len(parts) - 1; i >= 0; i-- {
+		s += strings.Repeat("0", 18-len(parts[i])) + parts[i]
+	}
+
+	return s
+}
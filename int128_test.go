@@ -0,0 +1,92 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInt128_String(t *testing.T) {
+	tcs := []struct {
+		v        Int128
+		expected string
+	}{
+		{NewInt128(0), "0"},
+		{NewInt128(-1), "-1"},
+		{NewInt128(math.MaxInt64), "9223372036854775807"},
+		{NewInt128(math.MinInt64), "-9223372036854775808"},
+		{NewInt128(math.MaxInt64).Add(NewInt128(1)), "9223372036854775808"},
+		{Int128{hi: 1}, "18446744073709551616"},
+		{Int128{hi: math.MaxInt64, lo: math.MaxUint64}, "170141183460469231731687303715884105727"},
+		{minInt128, "-170141183460469231731687303715884105728"},
+	}
+
+	for _, tc := range tcs {
+		if s := tc.v.String(); s != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, s)
+		}
+	}
+}
+
+func TestParseInt128(t *testing.T) {
+	tcs := []string{
+		"0",
+		"-1",
+		"42",
+		"1000000000000000000000000000000",
+		"-1000000000000000000000000000000",
+		"170141183460469231731687303715884105727",
+		"-170141183460469231731687303715884105728",
+	}
+
+	for _, tc := range tcs {
+		v, err := ParseInt128(tc)
+		if err != nil || v.String() != tc {
+			t.Errorf("Expected %s got %s (%v)", tc, v, err)
+		}
+	}
+
+	if v, err := ParseInt128("+42"); err != nil || v != NewInt128(42) {
+		t.Errorf("Expected 42 got %s (%v)", v, err)
+	}
+
+	for _, tc := range []string{"", "-", "+-1", "1.5", "abc", "170141183460469231731687303715884105728", "-170141183460469231731687303715884105729", "1000000000000000000000000000000000000000"} {
+		if _, err := ParseInt128(tc); err == nil {
+			t.Errorf("Expected error parsing %q", tc)
+		}
+	}
+}
+
+func TestInt128_Arithmetic(t *testing.T) {
+	max := NewInt128(math.MaxInt64)
+
+	if s := max.Add(max).Sub(max).String(); s != "9223372036854775807" {
+		t.Errorf("Expected %d got %s", int64(math.MaxInt64), s)
+	}
+
+	if s := max.Mul64(-4).String(); s != "-36893488147419103228" {
+		t.Errorf("Expected -36893488147419103228 got %s", s)
+	}
+
+	big, _ := ParseInt128("-100000000000000000000000000007")
+	q, r := big.QuoRem64(10)
+	if q.String() != "-10000000000000000000000000000" || r != -7 {
+		t.Errorf("Expected -10000000000000000000000000000, -7 got %s, %d", q, r)
+	}
+
+	q, r = NewInt128(7).QuoRem64(-2)
+	if q != NewInt128(-3) || r != 1 {
+		t.Errorf("Expected -3, 1 got %s, %d", q, r)
+	}
+
+	if NewInt128(-5).Cmp(NewInt128(3)) != -1 || max.Add(max).Cmp(max) != 1 || max.Cmp(max) != 0 {
+		t.Error("Unexpected comparison result")
+	}
+
+	if v, ok := NewInt128(-42).Int64(); !ok || v != -42 {
+		t.Errorf("Expected -42 got %d", v)
+	}
+
+	if _, ok := max.Add(NewInt128(1)).Int64(); ok {
+		t.Error("Expected int64 overflow")
+	}
+}
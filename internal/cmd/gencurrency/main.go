@@ -0,0 +1,362 @@
+// Command gencurrency regenerates the currency table of the money package from ISO 4217 and CLDR data.
+//
+// It reads the current table and keeps everything which is house style, like templates, separators and
+// cash rounding, while taking fractions and numeric codes from the ISO 4217 list and adding currencies
+// missing from the table, with their symbol from CLDR. Currencies no longer listed by ISO 4217 are kept,
+// so that existing Money doesn't change.
+//
+// The data is read from local files:
+//
+//	-iso   the ISO 4217 list one XML, as published by the ISO 4217 maintenance agency
+//	-cldr  the CLDR currencies JSON of a locale, e.g. cldr-numbers-full/main/en/currencies.json
+//
+// Either may be empty, which skips it, so running without data only rewrites the table in canonical form.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, "gencurrency:", err)
+		os.Exit(1)
+	}
+}
+
+// currency mirrors money.Currency, which can't be imported as its table is being generated.
+type currency struct {
+	Code         string
+	NumericCode  string
+	Fraction     int
+	CashRounding int64
+	Grapheme     string
+	Template     string
+	Decimal      string
+	Thousand     string
+}
+
+func run(args []string, log io.Writer) error {
+	fs := flag.NewFlagSet("gencurrency", flag.ContinueOnError)
+	table := fs.String("table", "currency_table.go", "the table to read and regenerate")
+	constants := fs.String("constants", "constants.go", "the file declaring the currency code constants")
+	iso := fs.String("iso", "", "the ISO 4217 list one XML")
+	cldr := fs.String("cldr", "", "the CLDR currencies JSON")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	declared, err := readConstants(*constants)
+	if err != nil {
+		return err
+	}
+
+	cs, err := readTable(*table)
+	if err != nil {
+		return err
+	}
+
+	if *iso != "" {
+		b, err := ioutil.ReadFile(*iso)
+		if err != nil {
+			return err
+		}
+
+		if err := mergeISO(cs, b, log); err != nil {
+			return err
+		}
+	}
+
+	if *cldr != "" {
+		b, err := ioutil.ReadFile(*cldr)
+		if err != nil {
+			return err
+		}
+
+		if err := mergeCLDR(cs, b); err != nil {
+			return err
+		}
+	}
+
+	src, err := generate(cs, declared)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(*table, src, 0644)
+}
+
+// readConstants returns the string constants declared in the given file.
+func readConstants(path string) (map[string]bool, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	declared := map[string]bool{}
+	for _, d := range f.Decls {
+		gd, ok := d.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST {
+			continue
+		}
+
+		for _, s := range gd.Specs {
+			for _, n := range s.(*ast.ValueSpec).Names {
+				declared[n.Name] = true
+			}
+		}
+	}
+
+	return declared, nil
+}
+
+// readTable returns the entries of the currencies map literal in the given file by code.
+func readTable(path string) (map[string]*currency, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var lit *ast.CompositeLit
+	ast.Inspect(f, func(n ast.Node) bool {
+		if vs, ok := n.(*ast.ValueSpec); ok && len(vs.Names) == 1 && vs.Names[0].Name == "currencies" && len(vs.Values) == 1 {
+			lit, _ = vs.Values[0].(*ast.CompositeLit)
+		}
+		return lit == nil
+	})
+
+	if lit == nil {
+		return nil, fmt.Errorf("%s doesn't declare the currencies table", path)
+	}
+
+	cs := map[string]*currency{}
+	for _, e := range lit.Elts {
+		kv, ok := e.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, errors.New("currencies table entries must be keyed")
+		}
+
+		code, err := value(kv.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		c, err := readCurrency(kv.Value)
+		if err != nil {
+			return nil, fmt.Errorf("currency '%s': %v", code, err)
+		}
+
+		c.Code = code
+		cs[code] = c
+	}
+
+	return cs, nil
+}
+
+// readCurrency reads a Currency literal with keyed fields.
+func readCurrency(e ast.Expr) (*currency, error) {
+	lit, ok := e.(*ast.CompositeLit)
+	if !ok {
+		return nil, errors.New("expected a composite literal")
+	}
+
+	c := &currency{}
+	for _, f := range lit.Elts {
+		kv, ok := f.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, errors.New("fields must be keyed")
+		}
+
+		v, err := value(kv.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		switch name := kv.Key.(*ast.Ident).Name; name {
+		case "Code":
+		case "NumericCode":
+			c.NumericCode = v
+		case "Grapheme":
+			c.Grapheme = v
+		case "Template":
+			c.Template = v
+		case "Decimal":
+			c.Decimal = v
+		case "Thousand":
+			c.Thousand = v
+		case "Fraction":
+			c.Fraction, err = strconv.Atoi(v)
+		case "CashRounding":
+			c.CashRounding, err = strconv.ParseInt(v, 10, 64)
+		default:
+			return nil, fmt.Errorf("unknown field '%s'", name)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// value returns the value of a literal, or the name of a code constant which is its own value.
+func value(e ast.Expr) (string, error) {
+	switch v := e.(type) {
+	case *ast.Ident:
+		return v.Name, nil
+	case *ast.BasicLit:
+		if v.Kind == token.STRING {
+			return strconv.Unquote(v.Value)
+		}
+		return v.Value, nil
+	}
+
+	return "", fmt.Errorf("unsupported expression %T", e)
+}
+
+// isoList is the ISO 4217 list one XML.
+type isoList struct {
+	Entries []struct {
+		Name struct {
+			IsFund string `xml:"IsFund,attr"`
+		} `xml:"CcyNm"`
+		Code       string `xml:"Ccy"`
+		Number     string `xml:"CcyNbr"`
+		MinorUnits string `xml:"CcyMnrUnts"`
+	} `xml:"CcyTbl>CcyNtry"`
+}
+
+// mergeISO takes fractions and numeric codes from the ISO 4217 list and adds the currencies missing from cs.
+// Fund codes and entries without a currency, like Antarctica, are skipped.
+func mergeISO(cs map[string]*currency, b []byte, log io.Writer) error {
+	var l isoList
+	if err := xml.Unmarshal(b, &l); err != nil {
+		return fmt.Errorf("invalid ISO 4217 list: %v", err)
+	}
+
+	for _, e := range l.Entries {
+		if e.Code == "" || strings.EqualFold(e.Name.IsFund, "true") {
+			continue
+		}
+
+		c, ok := cs[e.Code]
+		if !ok {
+			c = &currency{Code: e.Code, Grapheme: e.Code, Template: "1 $", Decimal: "."}
+			cs[e.Code] = c
+			fmt.Fprintf(log, "added %s\n", e.Code)
+		}
+
+		c.NumericCode = e.Number
+
+		// Precious metals and the like have no minor units, "N.A." in the list.
+		f, err := strconv.Atoi(e.MinorUnits)
+		if err != nil {
+			continue
+		}
+
+		if ok && f != c.Fraction {
+			fmt.Fprintf(log, "changed fraction of %s from %d to %d\n", e.Code, c.Fraction, f)
+			c.CashRounding = 0
+		}
+		c.Fraction = f
+	}
+
+	return nil
+}
+
+// cldrCurrencies is a CLDR currencies JSON of a single locale.
+type cldrCurrencies struct {
+	Main map[string]struct {
+		Numbers struct {
+			Currencies map[string]map[string]string `json:"currencies"`
+		} `json:"numbers"`
+	} `json:"main"`
+}
+
+// mergeCLDR sets the symbols of currencies whose grapheme is still their code, preferring the narrow one.
+// Graphemes chosen by hand are kept.
+func mergeCLDR(cs map[string]*currency, b []byte) error {
+	var d cldrCurrencies
+	if err := json.Unmarshal(b, &d); err != nil {
+		return fmt.Errorf("invalid CLDR currencies: %v", err)
+	}
+
+	for _, l := range d.Main {
+		for code, names := range l.Numbers.Currencies {
+			c, ok := cs[code]
+			if !ok || c.Grapheme != c.Code {
+				continue
+			}
+
+			if s := names["symbol-alt-narrow"]; s != "" {
+				c.Grapheme = s
+			} else if s := names["symbol"]; s != "" {
+				c.Grapheme = s
+			}
+		}
+	}
+
+	return nil
+}
+
+// generate returns the formatted source of the table, declaring constants for the codes not declared yet.
+func generate(cs map[string]*currency, declared map[string]bool) ([]byte, error) {
+	codes := make([]string, 0, len(cs))
+	for code := range cs {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gencurrency; DO NOT EDIT.\n\npackage money\n\n")
+
+	var missing []string
+	for _, code := range codes {
+		if !declared[code] && token.IsIdentifier(code) {
+			missing = append(missing, code)
+		}
+	}
+
+	if len(missing) > 0 {
+		b.WriteString("// Constants for currency codes added from ISO 4217 data.\nconst (\n")
+		for _, code := range missing {
+			fmt.Fprintf(&b, "%s = %q\n", code, code)
+		}
+		b.WriteString(")\n\n")
+	}
+
+	b.WriteString("// currencies represents a collection of currency.\nvar currencies = Currencies{\n")
+	for _, code := range codes {
+		c := cs[code]
+
+		key := strconv.Quote(code)
+		if token.IsIdentifier(code) {
+			key = code
+		}
+
+		fmt.Fprintf(&b, "%s: {Decimal: %s, Thousand: %s, Code: %s, Fraction: %d, ", key, strconv.QuoteToASCII(c.Decimal), strconv.QuoteToASCII(c.Thousand), key, c.Fraction)
+		if c.CashRounding != 0 {
+			fmt.Fprintf(&b, "CashRounding: %d, ", c.CashRounding)
+		}
+		fmt.Fprintf(&b, "NumericCode: %q, Grapheme: %s, Template: %s},\n", c.NumericCode, strconv.QuoteToASCII(c.Grapheme), strconv.QuoteToASCII(c.Template))
+	}
+	b.WriteString("}\n")
+
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const constants = `package money
+
+const (
+	EUR = "EUR"
+	TZS = "TZS"
+	XAU = "XAU"
+	EEK = "EEK"
+)
+`
+
+const table = `package money
+
+var currencies = Currencies{
+	EUR: {Decimal: ".", Thousand: "", Code: EUR, Fraction: 2, NumericCode: "978", Grapheme: "€", Template: "$1"},
+	TZS: {Decimal: ".", Thousand: "", Code: TZS, Fraction: 0, CashRounding: 50, NumericCode: "834", Grapheme: "TSh", Template: "$1"},
+	XAU: {Decimal: ".", Thousand: "", Code: XAU, Fraction: 0, NumericCode: "959", Grapheme: "oz t", Template: "1 $"},
+	EEK: {Decimal: ".", Thousand: "", Code: EEK, Fraction: 2, NumericCode: "", Grapheme: "kr", Template: "$1"},
+}
+`
+
+const iso = `<?xml version="1.0" encoding="UTF-8"?>
+<ISO_4217 Pblshd="2024-01-01">
+	<CcyTbl>
+		<CcyNtry><CtryNm>AUSTRIA</CtryNm><CcyNm>Euro</CcyNm><Ccy>EUR</Ccy><CcyNbr>978</CcyNbr><CcyMnrUnts>2</CcyMnrUnts></CcyNtry>
+		<CcyNtry><CtryNm>GERMANY</CtryNm><CcyNm>Euro</CcyNm><Ccy>EUR</Ccy><CcyNbr>978</CcyNbr><CcyMnrUnts>2</CcyMnrUnts></CcyNtry>
+		<CcyNtry><CtryNm>TANZANIA</CtryNm><CcyNm>Tanzanian Shilling</CcyNm><Ccy>TZS</Ccy><CcyNbr>834</CcyNbr><CcyMnrUnts>2</CcyMnrUnts></CcyNtry>
+		<CcyNtry><CtryNm>ZZ08_Gold</CtryNm><CcyNm>Gold</CcyNm><Ccy>XAU</Ccy><CcyNbr>959</CcyNbr><CcyMnrUnts>N.A.</CcyMnrUnts></CcyNtry>
+		<CcyNtry><CtryNm>VENEZUELA</CtryNm><CcyNm>Bolívar Soberano</CcyNm><Ccy>VES</Ccy><CcyNbr>928</CcyNbr><CcyMnrUnts>2</CcyMnrUnts></CcyNtry>
+		<CcyNtry><CtryNm>CHILE</CtryNm><CcyNm IsFund="true">Unidad de Fomento</CcyNm><Ccy>CLF</Ccy><CcyNbr>990</CcyNbr><CcyMnrUnts>4</CcyMnrUnts></CcyNtry>
+		<CcyNtry><CtryNm>ANTARCTICA</CtryNm><CcyNm>No universal currency</CcyNm></CcyNtry>
+	</CcyTbl>
+</ISO_4217>
+`
+
+const cldr = `{"main": {"en": {"numbers": {"currencies": {
+	"EUR": {"displayName": "Euro", "symbol": "€", "symbol-alt-narrow": "€"},
+	"VES": {"displayName": "Venezuelan Bolívar", "symbol": "VES", "symbol-alt-narrow": "Bs"}
+}}}}}`
+
+func write(t *testing.T, dir, name, content string) string {
+	p := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gencurrency")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	args := []string{
+		"-table", write(t, dir, "table.go", table),
+		"-constants", write(t, dir, "constants.go", constants),
+		"-iso", write(t, dir, "list-one.xml", iso),
+		"-cldr", write(t, dir, "currencies.json", cldr),
+	}
+
+	var log bytes.Buffer
+	if err := run(args, &log); err != nil {
+		t.Fatal(err)
+	}
+
+	b, _ := ioutil.ReadFile(args[1])
+	src := string(b)
+
+	expected := []string{
+		"// Code generated by gencurrency; DO NOT EDIT.",
+		`VES = "VES"`,
+		`EUR: {Decimal: ".", Thousand: "", Code: EUR, Fraction: 2, NumericCode: "978", Grapheme: "\u20ac", Template: "$1"},`,
+		`TZS: {Decimal: ".", Thousand: "", Code: TZS, Fraction: 2, NumericCode: "834", Grapheme: "TSh", Template: "$1"},`,
+		`XAU: {Decimal: ".", Thousand: "", Code: XAU, Fraction: 0, NumericCode: "959", Grapheme: "oz t", Template: "1 $"},`,
+		`EEK: {Decimal: ".", Thousand: "", Code: EEK, Fraction: 2, NumericCode: "", Grapheme: "kr", Template: "$1"},`,
+		`VES: {Decimal: ".", Thousand: "", Code: VES, Fraction: 2, NumericCode: "928", Grapheme: "Bs", Template: "1 $"},`,
+	}
+
+	for _, e := range expected {
+		if !strings.Contains(src, e) {
+			t.Errorf("Expected generated table to contain %s got\n%s", e, src)
+		}
+	}
+
+	if strings.Contains(src, "CLF") {
+		t.Errorf("Expected fund codes to be skipped got\n%s", src)
+	}
+
+	if log.String() != "changed fraction of TZS from 0 to 2\nadded VES\n" {
+		t.Errorf("Expected changes to be logged got %q", log.String())
+	}
+
+	// Regenerating the output without data doesn't change it.
+	if err := run(args[:4], &log); err != nil {
+		t.Fatal(err)
+	}
+
+	if again, _ := ioutil.ReadFile(args[1]); string(again) != src {
+		t.Errorf("Expected regenerated table to be stable got\n%s", again)
+	}
+}
+
+func TestRun_Errors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gencurrency")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := write(t, dir, "constants.go", constants)
+	tcs := [][]string{
+		{"-table", write(t, dir, "empty.go", "package money\n"), "-constants", c},
+		{"-table", write(t, dir, "field.go", "package money\n\nvar currencies = Currencies{EUR: {Unknown: 1}}\n"), "-constants", c},
+		{"-table", write(t, dir, "table.go", table), "-constants", c, "-iso", write(t, dir, "bad.xml", "<ISO_4217>")},
+		{"-table", write(t, dir, "table.go", table), "-constants", c, "-cldr", write(t, dir, "bad.json", "{")},
+		{"-table", filepath.Join(dir, "missing.go"), "-constants", c},
+	}
+
+	for _, args := range tcs {
+		if err := run(args, ioutil.Discard); err == nil {
+			t.Errorf("Expected error for %v", args)
+		}
+	}
+}
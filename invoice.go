@@ -0,0 +1,313 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// TaxMode defines at which level tax is rounded on an Invoice.
+type TaxMode int
+
+const (
+	// TaxPerLine calculates and rounds tax on every line separately and sums the results.
+	TaxPerLine TaxMode = iota
+	// TaxPerInvoice sums line amounts sharing the same tax rate and rounds tax once per rate.
+	TaxPerInvoice
+)
+
+// LineItem is a single invoice line: UnitPrice multiplied by Quantity,
+// reduced by Discount and taxed with TaxRate.
+// TaxCategory is only used to set TaxRate by ApplyTaxTable.
+// ExchangeRate converts a line priced in another currency into the currency of the invoice,
+// as recorded by ConvertLines for audit.
+type LineItem struct {
+	Description  string
+	UnitPrice    *Money
+	Quantity     int64
+	Discount     Rate
+	TaxRate      Rate
+	TaxCategory  TaxCategory
+	ExchangeRate Rate
+}
+
+// Invoice is a list of line items totaled in a single currency.
+// Lines priced in other currencies must be converted first with ConvertLines.
+// Discount is a fixed amount taken off the whole invoice before tax.
+type Invoice struct {
+	Currency string
+	Lines    []*LineItem
+	Discount *Money
+	TaxMode  TaxMode
+}
+
+// NewInvoice creates and returns new instance of Invoice.
+func NewInvoice(currencyCode string, mode TaxMode) (*Invoice, error) {
+	if GetCurrency(currencyCode) == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", currencyCode)
+	}
+
+	return &Invoice{Currency: currencyCode, TaxMode: mode}, nil
+}
+
+// AddLine appends a line item to the Invoice.
+func (inv *Invoice) AddLine(l *LineItem) *Invoice {
+	inv.Lines = append(inv.Lines, l)
+	return inv
+}
+
+// ApplyTaxTable sets the TaxRate of every line to the rate of its TaxCategory in the given country,
+// the standard rate for lines without category. On error no line is changed.
+func (inv *Invoice) ApplyTaxTable(t TaxTable, country string) error {
+	rates := make([]Rate, len(inv.Lines))
+	for i, l := range inv.Lines {
+		r, err := t.Rate(country, l.TaxCategory)
+		if err != nil {
+			return err
+		}
+		rates[i] = r
+	}
+
+	for i, l := range inv.Lines {
+		l.TaxRate = rates[i]
+	}
+
+	return nil
+}
+
+// ConvertLines records on every line priced in another currency the exchange rate from its currency
+// into the currency of the invoice, as given by the converter. Totals then convert these lines with
+// the recorded rates, rounding every line after its discount half away from zero, so that they don't
+// change with later rates. On error no line is changed.
+func (inv *Invoice) ConvertLines(c Converter) error {
+	currency := newCurrency(inv.Currency)
+	rates := make([]Rate, len(inv.Lines))
+	for i, l := range inv.Lines {
+		if l.UnitPrice == nil || l.UnitPrice.currency.equals(currency) {
+			continue
+		}
+
+		r, err := c.Rate(l.UnitPrice.CurrencyCode(), inv.Currency)
+		if err != nil {
+			return err
+		}
+
+		if r.Numerator <= 0 || r.Denominator <= 0 {
+			return errors.New("exchange rate must be higher than zero")
+		}
+		rates[i] = r
+	}
+
+	for i, l := range inv.Lines {
+		l.ExchangeRate = rates[i]
+	}
+
+	return nil
+}
+
+// Subtotal returns the sum of all lines after line and invoice discounts, excluding tax.
+func (inv *Invoice) Subtotal() (*Money, error) {
+	nets, err := inv.nets()
+	if err != nil {
+		return nil, err
+	}
+
+	var sum Amount
+	for _, n := range nets {
+		if sum, err = mutate.calc.addChecked(sum, n); err != nil {
+			return nil, err
+		}
+	}
+
+	return inv.money(sum), nil
+}
+
+// Tax returns the tax of the Invoice, rounded as defined by its TaxMode.
+func (inv *Invoice) Tax() (*Money, error) {
+	nets, err := inv.nets()
+	if err != nil {
+		return nil, err
+	}
+
+	tax, err := inv.tax(nets)
+	if err != nil {
+		return nil, err
+	}
+
+	return inv.money(tax), nil
+}
+
+// Total returns the amount due for the Invoice. Rounding is applied in the following places only:
+//
+//  1. every line discount is rounded half away from zero to the currency's smallest unit,
+//     and lines in other currencies are then converted and rounded the same way;
+//  2. the invoice discount is distributed over the discounted lines proportionally
+//     using Allocate, so no pennies are lost or created;
+//  3. tax is rounded half away from zero once per line (TaxPerLine)
+//     or once per distinct tax rate (TaxPerInvoice).
+//
+// Unit price multiplied by quantity is always exact. Total is the sum of Subtotal and Tax.
+// Amounts not fitting into an Amount fail with ErrOverflow, like Subtotal and Tax do.
+func (inv *Invoice) Total() (*Money, error) {
+	nets, err := inv.nets()
+	if err != nil {
+		return nil, err
+	}
+
+	tax, err := inv.tax(nets)
+	if err != nil {
+		return nil, err
+	}
+
+	total := tax
+	for _, n := range nets {
+		if total, err = mutate.calc.addChecked(total, n); err != nil {
+			return nil, err
+		}
+	}
+
+	return inv.money(total), nil
+}
+
+func (inv *Invoice) money(a Amount) *Money {
+	return &Money{amount: a, currency: newCurrency(inv.Currency).get()}
+}
+
+// nets returns the amount of every line after line and invoice discounts.
+func (inv *Invoice) nets() ([]Amount, error) {
+	currency := newCurrency(inv.Currency)
+	nets := make([]Amount, len(inv.Lines))
+	ratios := make([]int, len(inv.Lines))
+
+	for i, l := range inv.Lines {
+		if l.UnitPrice == nil {
+			return nil, errors.New("line item has no unit price")
+		}
+
+		foreign := !l.UnitPrice.currency.equals(currency)
+		if foreign && l.ExchangeRate.IsZero() {
+			return nil, ErrCurrencyMismatch
+		}
+
+		if err := l.Discount.validate(); err != nil {
+			return nil, err
+		}
+
+		gross, err := mutate.calc.mulDivRound(l.UnitPrice.amount, l.Quantity, 1, RoundHalfUp)
+		if err != nil {
+			return nil, err
+		}
+
+		discount, err := l.Discount.apply(gross)
+		if err != nil {
+			return nil, err
+		}
+
+		if nets[i], err = mutate.calc.subtractChecked(gross, discount); err != nil {
+			return nil, err
+		}
+
+		if foreign {
+			net := &Money{amount: nets[i], currency: l.UnitPrice.currency}
+			converted, _, err := net.convert(currency.get(), RateTable{}.Set(net.CurrencyCode(), inv.Currency, l.ExchangeRate))
+			if err != nil {
+				return nil, err
+			}
+			nets[i] = converted.amount
+		}
+
+		if nets[i] == math.MinInt64 {
+			return nil, ErrOverflow
+		}
+		ratios[i] = int(mutate.calc.absolute(nets[i]))
+	}
+
+	if inv.Discount == nil || inv.Discount.IsZero() {
+		return nets, nil
+	}
+
+	if !inv.Discount.currency.equals(currency) {
+		return nil, ErrCurrencyMismatch
+	}
+
+	if len(nets) == 0 {
+		return nil, errors.New("invoice discount requires at least one line")
+	}
+
+	// Lines without any value left still have to share the discount. Allocate sums the ratios on
+	// 128 bits, so that lines close to the largest amount share it correctly.
+	empty := true
+	for _, r := range ratios {
+		empty = empty && r == 0
+	}
+	if empty {
+		for i := range ratios {
+			ratios[i] = 1
+		}
+	}
+
+	parts, err := inv.Discount.Allocate(ratios...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, p := range parts {
+		if nets[i], err = mutate.calc.subtractChecked(nets[i], p.amount); err != nil {
+			return nil, err
+		}
+	}
+
+	return nets, nil
+}
+
+func (inv *Invoice) tax(nets []Amount) (Amount, error) {
+	for _, l := range inv.Lines {
+		if err := l.TaxRate.validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	var tax Amount
+	switch inv.TaxMode {
+	case TaxPerLine:
+		for i, l := range inv.Lines {
+			t, err := l.TaxRate.apply(nets[i])
+			if err != nil {
+				return 0, err
+			}
+
+			if tax, err = mutate.calc.addChecked(tax, t); err != nil {
+				return 0, err
+			}
+		}
+	case TaxPerInvoice:
+		rates := make([]Rate, 0)
+		bases := make(map[Rate]Amount)
+		for i, l := range inv.Lines {
+			r := l.TaxRate.normalize()
+			if _, ok := bases[r]; !ok {
+				rates = append(rates, r)
+			}
+			b, err := mutate.calc.addChecked(bases[r], nets[i])
+			if err != nil {
+				return 0, err
+			}
+			bases[r] = b
+		}
+
+		for _, r := range rates {
+			t, err := r.apply(bases[r])
+			if err != nil {
+				return 0, err
+			}
+
+			if tax, err = mutate.calc.addChecked(tax, t); err != nil {
+				return 0, err
+			}
+		}
+	default:
+		return 0, errors.New("unknown tax mode")
+	}
+
+	return tax, nil
+}
@@ -0,0 +1,219 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCalculator_MultiplyRatio(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		n, d     int64
+		expected int64
+	}{
+		{100, 21, 100, 21},
+		{1, 1, 2, 1},
+		{-1, 1, 2, -1},
+		{1, 1, 3, 0},
+		{2, 1, 3, 1},
+		{-2, 1, 3, -1},
+		{1999, 8875, 100000, 177},
+		{0, 21, 100, 0},
+	}
+
+	for _, tc := range tcs {
+		r, err := mutate.calc.multiplyRatio(tc.amount, tc.n, tc.d)
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected %d * %d / %d = %d got %d (%v)", tc.amount, tc.n, tc.d, tc.expected, r, err)
+		}
+	}
+
+	if _, err := mutate.calc.multiplyRatio(math.MaxInt64, 3, 2); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func TestInvoice_Total(t *testing.T) {
+	price, _ := New(333, EUR)
+	tcs := []struct {
+		mode     TaxMode
+		subtotal int64
+		tax      int64
+		total    int64
+	}{
+		// 3 lines of 3.33 at 21%: 0.6993 per line rounds to 0.70, total on 9.99 rounds to 2.10.
+		{TaxPerLine, 999, 210, 1209},
+		{TaxPerInvoice, 999, 210, 1209},
+	}
+
+	for _, tc := range tcs {
+		inv, _ := NewInvoice(EUR, tc.mode)
+		for i := 0; i < 3; i++ {
+			inv.AddLine(&LineItem{UnitPrice: price, Quantity: 1, TaxRate: Rate{21, 100}})
+		}
+
+		assertInvoice(t, inv, tc.subtotal, tc.tax, tc.total)
+	}
+}
+
+func TestInvoice_TaxModes(t *testing.T) {
+	price, _ := New(5, EUR)
+
+	// 0.05 at 10% is 0.005 which rounds up to 0.01 on every line,
+	// while 10 * 0.05 at 10% is exactly 0.05 on the whole invoice.
+	perLine, _ := NewInvoice(EUR, TaxPerLine)
+	perInvoice, _ := NewInvoice(EUR, TaxPerInvoice)
+	for i := 0; i < 10; i++ {
+		perLine.AddLine(&LineItem{UnitPrice: price, Quantity: 1, TaxRate: Rate{10, 100}})
+		perInvoice.AddLine(&LineItem{UnitPrice: price, Quantity: 1, TaxRate: Rate{1, 10}})
+	}
+
+	assertInvoice(t, perLine, 50, 10, 60)
+	assertInvoice(t, perInvoice, 50, 5, 55)
+}
+
+func TestInvoice_Discounts(t *testing.T) {
+	a, _ := New(1000, EUR)
+	b, _ := New(333, EUR)
+	discount, _ := New(100, EUR)
+
+	inv, _ := NewInvoice(EUR, TaxPerLine)
+	inv.AddLine(&LineItem{UnitPrice: a, Quantity: 2, Discount: Rate{10, 100}, TaxRate: Rate{20, 100}})
+	inv.AddLine(&LineItem{UnitPrice: b, Quantity: 3, TaxRate: Rate{5, 100}})
+	inv.Discount = discount
+
+	// Lines: 20.00 - 2.00 = 18.00 and 9.99; invoice discount of 1.00 allocated as 0.65 and 0.35.
+	// Tax: 17.35 * 20% = 3.47, 9.64 * 5% = 0.482 -> 0.48.
+	assertInvoice(t, inv, 2699, 395, 3094)
+}
+
+func TestInvoice_LargeDiscountShares(t *testing.T) {
+	debit, _ := New(math.MaxInt64-1000, EUR)
+	credit, _ := New(-(math.MaxInt64 - 2000), EUR)
+	discount, _ := New(100, EUR)
+
+	inv, _ := NewInvoice(EUR, TaxPerLine)
+	inv.AddLine(&LineItem{UnitPrice: debit, Quantity: 1})
+	inv.AddLine(&LineItem{UnitPrice: credit, Quantity: 1})
+	inv.Discount = discount
+
+	// The ratios add up beyond an int64, the discount is still shared 51:49.
+	nets, err := inv.nets()
+	if err != nil || nets[0] != math.MaxInt64-1051 || nets[1] != -(math.MaxInt64-1951) {
+		t.Errorf("Expected nets %d and %d got %v (%v)", int64(math.MaxInt64-1051), -(math.MaxInt64 - 1951), nets, err)
+	}
+
+	assertInvoice(t, inv, 900, 0, 900)
+}
+
+func TestInvoice_Errors(t *testing.T) {
+	if _, err := NewInvoice("UNKNOWN", TaxPerLine); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+
+	usd, _ := New(100, USD)
+	inv, _ := NewInvoice(EUR, TaxPerLine)
+	inv.AddLine(&LineItem{UnitPrice: usd, Quantity: 1})
+	if _, err := inv.Total(); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	eur, _ := New(100, EUR)
+	inv, _ = NewInvoice(EUR, TaxPerLine)
+	inv.AddLine(&LineItem{UnitPrice: eur, Quantity: 1, TaxRate: Rate{1, 0}})
+	if _, err := inv.Total(); err == nil {
+		t.Error("Expected error for invalid tax rate")
+	}
+
+	inv, _ = NewInvoice(EUR, TaxMode(42))
+	inv.AddLine(&LineItem{UnitPrice: eur, Quantity: 1})
+	if _, err := inv.Tax(); err == nil {
+		t.Error("Expected error for unknown tax mode")
+	}
+
+	large, _ := New(math.MaxInt64/2, EUR)
+	tcs := []*LineItem{
+		{UnitPrice: eur, Quantity: math.MaxInt64 / 10},
+		{UnitPrice: large, Quantity: 1, TaxRate: Rate{21, 100}},
+	}
+
+	for _, l := range tcs {
+		inv, _ = NewInvoice(EUR, TaxPerLine)
+		inv.AddLine(l)
+		inv.AddLine(&LineItem{UnitPrice: large, Quantity: 1})
+
+		if _, err := inv.Total(); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Expected %v for %d x %d got %v", ErrOverflow, l.UnitPrice.amount, l.Quantity, err)
+		}
+	}
+
+	inv, _ = NewInvoice(EUR, TaxPerLine)
+	inv.AddLine(&LineItem{UnitPrice: large, Quantity: 2})
+	inv.AddLine(&LineItem{UnitPrice: large, Quantity: 2})
+	if _, err := inv.Subtotal(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func assertInvoice(t *testing.T, inv *Invoice, subtotal, tax, total int64) {
+	t.Helper()
+
+	s, err := inv.Subtotal()
+	if err != nil || s.amount != subtotal {
+		t.Errorf("Expected subtotal %d got %v (%v)", subtotal, s, err)
+	}
+
+	x, err := inv.Tax()
+	if err != nil || x.amount != tax {
+		t.Errorf("Expected tax %d got %v (%v)", tax, x, err)
+	}
+
+	o, err := inv.Total()
+	if err != nil || o.amount != total {
+		t.Errorf("Expected total %d got %v (%v)", total, o, err)
+	}
+}
+
+func TestInvoice_ConvertLines(t *testing.T) {
+	eur, _ := New(1000, EUR)
+	usd, _ := New(1000, USD)
+	jpy, _ := New(1000, JPY)
+
+	inv, _ := NewInvoice(EUR, TaxPerLine)
+	inv.AddLine(&LineItem{UnitPrice: eur, Quantity: 1, TaxRate: Rate{21, 100}}).
+		AddLine(&LineItem{UnitPrice: usd, Quantity: 2, Discount: Rate{10, 100}, TaxRate: Rate{21, 100}}).
+		AddLine(&LineItem{UnitPrice: jpy, Quantity: 1, TaxRate: Rate{21, 100}})
+
+	rates := RateTable{}.Set(USD, EUR, Rate{9, 10})
+	if err := inv.ConvertLines(rates); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected %v got %v", ErrNoRate, err)
+	}
+
+	if !inv.Lines[1].ExchangeRate.IsZero() {
+		t.Errorf("Expected lines to be left untouched on error got %v", inv.Lines[1].ExchangeRate)
+	}
+
+	rates.Set(JPY, EUR, Rate{6, 1000})
+	if err := inv.ConvertLines(rates); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Rate{{}, {9, 10}, {6, 1000}}
+	for i, l := range inv.Lines {
+		if l.ExchangeRate != expected[i] {
+			t.Errorf("Expected line %d to record %v got %v", i, expected[i], l.ExchangeRate)
+		}
+	}
+
+	// €10.00, $18.00 at 0.9 is €16.20 and ¥1000 at 0.006 is €6.00, taxed 2.10, 3.40 and 1.26.
+	assertInvoice(t, inv, 3220, 676, 3896)
+
+	// Totals keep using the recorded rates.
+	rates.Set(USD, EUR, Rate{1, 1})
+	assertInvoice(t, inv, 3220, 676, 3896)
+
+	if err := inv.ConvertLines(RateTable{}.Set(USD, EUR, Rate{}).Set(JPY, EUR, Rate{1, 1})); err == nil {
+		t.Error("Expected error for zero exchange rate")
+	}
+}
@@ -0,0 +1,75 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// maxISO20022Amount is the highest amount fitting into the 18 digits ISO 20022 allows.
+const maxISO20022Amount = 999999999999999999
+
+// ISO20022Amount is an amount as used in ISO 20022 messages like pain.001 and camt.053, with the currency
+// as an attribute, so that it can be embedded into XML structs, e.g. as InstdAmt:
+//
+//	<InstdAmt Ccy="EUR">12.34</InstdAmt>
+type ISO20022Amount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// ISO20022 returns Money as an ISO 20022 amount, written with a dot decimal separator, no grouping and the
+// currency's decimal places. ISO 20022 amounts can't be negative, their direction is given separately,
+// and must be in the currency's smallest unit, so micros have to be settled first.
+func (m *Money) ISO20022() (ISO20022Amount, error) {
+	c := m.currency.get()
+	if err := c.assertSmallestUnit(); err != nil {
+		return ISO20022Amount{}, err
+	}
+
+	if m.amount < 0 {
+		return ISO20022Amount{}, errors.New("iso 20022 amounts can't be negative")
+	}
+
+	if m.amount > maxISO20022Amount {
+		return ISO20022Amount{}, errors.New("iso 20022 amounts can't have more than 18 digits")
+	}
+
+	return ISO20022Amount{Currency: c.Code, Value: string(appendFixed(nil, m.amount, c.Fraction))}, nil
+}
+
+// Money parses the ISO 20022 amount, failing if it's negative, grouped, has more than 18 digits
+// or more decimal places than its currency.
+func (a ISO20022Amount) Money() (*Money, error) {
+	currency := GetCurrency(a.Currency)
+	if currency == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", a.Currency)
+	}
+
+	v := strings.TrimSpace(a.Value)
+	digits, decimals, point := 0, 0, false
+	for _, c := range v {
+		switch {
+		case c >= '0' && c <= '9':
+			digits++
+			if point {
+				decimals++
+			}
+		case c == '.' && !point && digits > 0:
+			point = true
+		default:
+			return nil, fmt.Errorf("invalid iso 20022 amount '%s'", a.Value)
+		}
+	}
+
+	switch {
+	case digits == 0 || strings.HasSuffix(v, "."):
+		return nil, fmt.Errorf("invalid iso 20022 amount '%s'", a.Value)
+	case digits > 18:
+		return nil, fmt.Errorf("iso 20022 amount '%s' has more than 18 digits", a.Value)
+	case decimals > currency.Fraction:
+		return nil, fmt.Errorf("iso 20022 amount '%s' has more than %d decimal places", a.Value, currency.Fraction)
+	}
+
+	return NewFromString(v, a.Currency)
+}
@@ -0,0 +1,108 @@
+package money
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestMoney_ISO20022(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+	}{
+		{1234, EUR, `<InstdAmt Ccy="EUR">12.34</InstdAmt>`},
+		{5, EUR, `<InstdAmt Ccy="EUR">0.05</InstdAmt>`},
+		{123456789, EUR, `<InstdAmt Ccy="EUR">1234567.89</InstdAmt>`},
+		{1000, JPY, `<InstdAmt Ccy="JPY">1000</InstdAmt>`},
+		{1500, BHD, `<InstdAmt Ccy="BHD">1.500</InstdAmt>`},
+		{999999999999999999, EUR, `<InstdAmt Ccy="EUR">9999999999999999.99</InstdAmt>`},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		a, err := m.ISO20022()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b, err := xml.Marshal(struct {
+			XMLName xml.Name `xml:"InstdAmt"`
+			ISO20022Amount
+		}{ISO20022Amount: a})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(b) != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, b)
+		}
+
+		var u struct {
+			ISO20022Amount
+		}
+		if err := xml.Unmarshal(b, &u); err != nil {
+			t.Fatal(err)
+		}
+
+		r, err := u.Money()
+		if err != nil || *r != *m {
+			t.Errorf("Expected %s to parse to %v got %v, %v", b, m, r, err)
+		}
+	}
+
+	for _, amount := range []int64{-1, 1000000000000000000} {
+		m, _ := New(amount, EUR)
+		if _, err := m.ISO20022(); err == nil {
+			t.Errorf("Expected error for %d", amount)
+		}
+	}
+
+	micros, _ := NewFromMicros(1234567, EUR)
+	eur, _ := New(1234, EUR)
+	rescaled, _ := eur.Rescale(4, RoundHalfUp)
+	for _, m := range []*Money{micros, rescaled} {
+		if _, err := m.ISO20022(); err == nil {
+			t.Errorf("Expected error for %v", m)
+		}
+	}
+}
+
+func TestISO20022Amount_Money(t *testing.T) {
+	tcs := []struct {
+		value    string
+		code     string
+		expected int64
+		err      bool
+	}{
+		{"12.34", EUR, 1234, false},
+		{" 12.3\n", EUR, 1230, false},
+		{"12", EUR, 1200, false},
+		{"0.5", BHD, 500, false},
+		{"12.345", EUR, 0, true},
+		{"12.5", JPY, 0, true},
+		{"1,234.56", EUR, 0, true},
+		{"-1.00", EUR, 0, true},
+		{"+1.00", EUR, 0, true},
+		{".5", EUR, 0, true},
+		{"5.", EUR, 0, true},
+		{"1.2.3", EUR, 0, true},
+		{"", EUR, 0, true},
+		{"12345678901234567.89", EUR, 0, true},
+		{"1.00", "XXX", 0, true},
+	}
+
+	for _, tc := range tcs {
+		m, err := ISO20022Amount{Currency: tc.code, Value: tc.value}.Money()
+		if tc.err {
+			if err == nil {
+				t.Errorf("Expected error for %q got %v", tc.value, m)
+			}
+			continue
+		}
+
+		if err != nil || m.amount != tc.expected || m.CurrencyCode() != tc.code {
+			t.Errorf("Expected %q to be %d got %v, %v", tc.value, tc.expected, m, err)
+		}
+	}
+}
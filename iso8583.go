@@ -0,0 +1,64 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// maxISO8583Amount is the highest amount fitting into the 12 digits of an ISO 8583 amount field.
+const maxISO8583Amount = 999999999999
+
+// ISO8583 returns Money as ISO 8583 card network fields: the amount as 12 zero-padded digits in the
+// currency's smallest unit, as in DE4, and the ISO 4217 numeric currency code, as in DE49.
+// The amount can't be negative, the transaction type tells its direction.
+func (m *Money) ISO8583() (amount string, currency string, err error) {
+	c := m.currency.get()
+	if c.NumericCode == "" {
+		return "", "", fmt.Errorf("currency '%s' has no numeric code", c.Code)
+	}
+
+	if err := c.assertSmallestUnit(); err != nil {
+		return "", "", err
+	}
+
+	if m.amount < 0 {
+		return "", "", errors.New("iso 8583 amounts can't be negative")
+	}
+
+	if m.amount > maxISO8583Amount {
+		return "", "", errors.New("iso 8583 amounts can't have more than 12 digits")
+	}
+
+	return fmt.Sprintf("%012d", m.amount), c.NumericCode, nil
+}
+
+// FromISO8583 creates and returns new Money from ISO 8583 card network fields,
+// a 12 digits amount like DE4 and a numeric currency code like DE49.
+func FromISO8583(amount, currencyCode string) (*Money, error) {
+	var currency *Currency
+	if currencyCode != "" {
+		currency = currencies.CurrencyByNumericCode(currencyCode)
+	}
+
+	if currency == nil {
+		return nil, fmt.Errorf("invalid numeric currency code '%s'", currencyCode)
+	}
+
+	if len(amount) != 12 {
+		return nil, fmt.Errorf("invalid iso 8583 amount '%s', expected 12 digits", amount)
+	}
+
+	for _, c := range amount {
+		if c < '0' || c > '9' {
+			return nil, fmt.Errorf("invalid iso 8583 amount '%s', expected 12 digits", amount)
+		}
+	}
+
+	a, err := strconv.ParseInt(amount, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: a, currency: currency}, nil
+}
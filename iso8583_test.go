@@ -0,0 +1,67 @@
+package money
+
+import "testing"
+
+func TestMoney_ISO8583(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+		numeric  string
+	}{
+		{1234, EUR, "000000001234", "978"},
+		{0, USD, "000000000000", "840"},
+		{1000, JPY, "000000001000", "392"},
+		{1500, BHD, "000000001500", "048"},
+		{999999999999, GBP, "999999999999", "826"},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		a, c, err := m.ISO8583()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a != tc.expected || c != tc.numeric {
+			t.Errorf("Expected %s %s got %s %s", tc.expected, tc.numeric, a, c)
+		}
+
+		r, err := FromISO8583(a, c)
+		if err != nil || *r != *m {
+			t.Errorf("Expected %s %s to parse to %v got %v, %v", a, c, m, r, err)
+		}
+	}
+}
+
+func TestMoney_ISO8583_Errors(t *testing.T) {
+	negative, _ := New(-1, EUR)
+	large, _ := New(1000000000000, EUR)
+	micros, _ := NewFromMicros(1, EUR)
+	AddCurrency("XNN", "N", "1 $", ".", "", 2)
+	custom, _ := New(1, "XNN")
+
+	for _, m := range []*Money{negative, large, micros, custom} {
+		if _, _, err := m.ISO8583(); err == nil {
+			t.Errorf("Expected error for %v", m)
+		}
+	}
+
+	tcs := []struct {
+		amount string
+		code   string
+	}{
+		{"1234", "978"},
+		{"0000000012345", "978"},
+		{"00000000123a", "978"},
+		{"-00000001234", "978"},
+		{"000000001234", "000"},
+		{"000000001234", ""},
+	}
+
+	for _, tc := range tcs {
+		if _, err := FromISO8583(tc.amount, tc.code); err == nil {
+			t.Errorf("Expected error for %s %s", tc.amount, tc.code)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package ledger
+
+import (
+	"errors"
+
+	money "github.com/bluelabs-eu/go-money"
+)
+
+var (
+	// ErrInsufficientFunds happens when an amount exceeds the available funds of a balance.
+	ErrInsufficientFunds = errors.New("insufficient funds")
+
+	// ErrExceedsHeld happens when capturing or releasing more than is held.
+	ErrExceedsHeld = errors.New("amount exceeds held funds")
+
+	// ErrExceedsPending happens when settling more than is pending.
+	ErrExceedsPending = errors.New("amount exceeds pending funds")
+
+	// ErrInvalidAmount happens when an operation is given a nil, zero or negative amount.
+	ErrInvalidAmount = errors.New("amount must be positive")
+)
+
+// Balance tracks the funds of an account in one currency in three buckets: available funds which can be
+// spent, pending funds which were received but aren't settled yet, and held funds which are reserved by
+// authorizations. No bucket ever goes negative, and every operation either fully applies or leaves the
+// balance untouched. Amounts in another currency fail with money.ErrCurrencyMismatch.
+type Balance struct {
+	available *money.Money
+	pending   *money.Money
+	held      *money.Money
+}
+
+// NewBalance creates and returns new empty Balance in the given currency.
+func NewBalance(currencyCode string) (*Balance, error) {
+	zero, err := money.New(0, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Balance{available: zero, pending: zero, held: zero}, nil
+}
+
+// Available returns the funds which can be spent.
+func (b *Balance) Available() *money.Money {
+	return b.available
+}
+
+// Pending returns the funds received but not settled yet.
+func (b *Balance) Pending() *money.Money {
+	return b.pending
+}
+
+// Held returns the funds reserved by authorizations.
+func (b *Balance) Held() *money.Money {
+	return b.held
+}
+
+// Total returns the sum of all buckets.
+func (b *Balance) Total() (*money.Money, error) {
+	return b.available.AddAll(b.pending, b.held)
+}
+
+// Deposit adds amount to the available funds.
+func (b *Balance) Deposit(amount *money.Money) error {
+	return b.move(amount, nil, &b.available, nil)
+}
+
+// AddPending adds amount to the pending funds.
+func (b *Balance) AddPending(amount *money.Money) error {
+	return b.move(amount, nil, &b.pending, nil)
+}
+
+// Settle moves amount from the pending to the available funds.
+func (b *Balance) Settle(amount *money.Money) error {
+	return b.move(amount, &b.pending, &b.available, ErrExceedsPending)
+}
+
+// Authorize reserves amount of the available funds by moving it to the held funds.
+func (b *Balance) Authorize(amount *money.Money) error {
+	return b.move(amount, &b.available, &b.held, ErrInsufficientFunds)
+}
+
+// Capture takes amount out of the held funds, e.g. when an authorization is charged.
+// Captures may be partial, the rest stays held until it's captured or released.
+func (b *Balance) Capture(amount *money.Money) error {
+	return b.move(amount, &b.held, nil, ErrExceedsHeld)
+}
+
+// Release returns amount of the held funds to the available funds, e.g. when an authorization is voided.
+func (b *Balance) Release(amount *money.Money) error {
+	return b.move(amount, &b.held, &b.available, ErrExceedsHeld)
+}
+
+// move subtracts amount from the from bucket, failing with exceeded if it isn't enough, and adds it to the
+// to bucket. A nil bucket means the amount enters or leaves the balance.
+func (b *Balance) move(amount *money.Money, from, to **money.Money, exceeded error) error {
+	if amount == nil || !amount.IsPositive() {
+		return ErrInvalidAmount
+	}
+
+	if !amount.SameCurrency(b.available) {
+		return money.ErrCurrencyMismatch
+	}
+
+	var f, t *money.Money
+	if from != nil {
+		if less, _ := (*from).LessThan(amount); less {
+			return exceeded
+		}
+		f, _ = (*from).Subtract(amount)
+	}
+
+	if to != nil {
+		var err error
+		if t, err = (*to).AddAll(amount); err != nil {
+			return err
+		}
+	}
+
+	if from != nil {
+		*from = f
+	}
+	if to != nil {
+		*to = t
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package ledger
+
+import (
+	"math"
+	"testing"
+
+	money "github.com/bluelabs-eu/go-money"
+)
+
+func TestBalance(t *testing.T) {
+	b, err := NewBalance(money.EUR)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	steps := []struct {
+		op                       func(*money.Money) error
+		amount                   int64
+		available, pending, held int64
+	}{
+		{b.AddPending, 1000, 0, 1000, 0},
+		{b.Settle, 600, 600, 400, 0},
+		{b.Deposit, 400, 1000, 400, 0},
+		{b.Authorize, 700, 300, 400, 700},
+		{b.Capture, 500, 300, 400, 200},
+		{b.Release, 200, 500, 400, 0},
+	}
+
+	for i, s := range steps {
+		if err := s.op(eur(s.amount)); err != nil {
+			t.Fatalf("Unexpected error at step %d: %v", i, err)
+		}
+
+		a, p, h := b.Available().AmountUnformatted(), b.Pending().AmountUnformatted(), b.Held().AmountUnformatted()
+		if a != s.available || p != s.pending || h != s.held {
+			t.Errorf("Expected step %d to leave %d/%d/%d got %d/%d/%d", i, s.available, s.pending, s.held, a, p, h)
+		}
+	}
+
+	if total, err := b.Total(); err != nil || total.AmountUnformatted() != 900 {
+		t.Errorf("Expected total %d got %v, %v", 900, total, err)
+	}
+
+	if _, err := NewBalance("XXX"); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+}
+
+func TestBalance_Errors(t *testing.T) {
+	b, _ := NewBalance(money.EUR)
+	_ = b.Deposit(eur(100))
+	_ = b.AddPending(eur(50))
+	_ = b.Authorize(eur(30))
+
+	tcs := []struct {
+		op       func(*money.Money) error
+		amount   *money.Money
+		expected error
+	}{
+		{b.Authorize, eur(71), ErrInsufficientFunds},
+		{b.Capture, eur(31), ErrExceedsHeld},
+		{b.Release, eur(31), ErrExceedsHeld},
+		{b.Settle, eur(51), ErrExceedsPending},
+		{b.Deposit, usd(1), money.ErrCurrencyMismatch},
+		{b.Deposit, eur(0), ErrInvalidAmount},
+		{b.Deposit, eur(-1), ErrInvalidAmount},
+		{b.Deposit, nil, ErrInvalidAmount},
+		{b.Deposit, eur(math.MaxInt64), money.ErrOverflow},
+	}
+
+	for i, tc := range tcs {
+		if err := tc.op(tc.amount); err != tc.expected {
+			t.Errorf("Expected case %d to fail with %v got %v", i, tc.expected, err)
+		}
+
+		a, p, h := b.Available().AmountUnformatted(), b.Pending().AmountUnformatted(), b.Held().AmountUnformatted()
+		if a != 70 || p != 50 || h != 30 {
+			t.Errorf("Expected failed case %d to leave 70/50/30 got %d/%d/%d", i, a, p, h)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	money "github.com/bluelabs-eu/go-money"
+)
+
+var (
+	// ErrOverCapture happens when capturing or releasing more than remains of a hold.
+	ErrOverCapture = errors.New("amount exceeds remaining hold")
+
+	// ErrHoldNotFound happens when no hold has the given id.
+	ErrHoldNotFound = errors.New("hold not found")
+
+	// ErrHoldExpired happens when capturing from a hold past its expiry.
+	ErrHoldExpired = errors.New("hold expired")
+)
+
+// Hold is a reservation of funds placed with Escrow, captured or released in parts until nothing remains.
+type Hold struct {
+	ID        string
+	Amount    *money.Money
+	Captured  *money.Money
+	Released  *money.Money
+	ExpiresAt time.Time
+}
+
+// Remaining returns the part of the hold which is neither captured nor released.
+func (h *Hold) Remaining() *money.Money {
+	r, _ := h.Amount.Subtract(h.Captured)
+	r, _ = r.Subtract(h.Released)
+	return r
+}
+
+// expired tells whether the hold is past its expiry at now. A zero ExpiresAt never expires.
+func (h *Hold) expired(now time.Time) bool {
+	return !h.ExpiresAt.IsZero() && !now.Before(h.ExpiresAt)
+}
+
+// Escrow places holds against a Balance, moving their funds from available to held until they are captured,
+// released or expire. Now is used to decide expiry and defaults to time.Now when nil, so that Escrow
+// only needs a Balance to be used.
+type Escrow struct {
+	Balance *Balance
+	Now     func() time.Time
+
+	holds map[string]*Hold
+}
+
+// NewEscrow creates and returns new Escrow placing holds against b.
+func NewEscrow(b *Balance) *Escrow {
+	return &Escrow{Balance: b, Now: time.Now, holds: map[string]*Hold{}}
+}
+
+// Hold places a hold for amount under id, failing with ErrInsufficientFunds if the balance can't cover it.
+// A zero expiresAt places a hold which never expires.
+func (e *Escrow) Hold(id string, amount *money.Money, expiresAt time.Time) (*Hold, error) {
+	if _, ok := e.holds[id]; ok {
+		return nil, fmt.Errorf("hold '%s' already exists", id)
+	}
+
+	if err := e.Balance.Authorize(amount); err != nil {
+		return nil, err
+	}
+
+	if e.holds == nil {
+		e.holds = map[string]*Hold{}
+	}
+
+	zero := amount.WithAmount(0)
+	h := &Hold{ID: id, Amount: amount, Captured: zero, Released: zero, ExpiresAt: expiresAt}
+	e.holds[id] = h
+
+	return h, nil
+}
+
+// Get returns the hold with the given id, or nil if there is none.
+func (e *Escrow) Get(id string) *Hold {
+	return e.holds[id]
+}
+
+// Capture takes amount out of the hold with the given id. Captures may be partial, failing with
+// ErrOverCapture if amount is more than remains and with ErrHoldExpired once the hold has expired.
+func (e *Escrow) Capture(id string, amount *money.Money) error {
+	h, err := e.remaining(id, amount)
+	if err != nil {
+		return err
+	}
+
+	if h.expired(e.now()) {
+		return fmt.Errorf("%w: '%s'", ErrHoldExpired, id)
+	}
+
+	if err := e.Balance.Capture(amount); err != nil {
+		return err
+	}
+
+	h.Captured, _ = h.Captured.Add(amount)
+	return nil
+}
+
+// Release returns amount of the hold with the given id to the available funds,
+// failing with ErrOverCapture if amount is more than remains.
+func (e *Escrow) Release(id string, amount *money.Money) error {
+	h, err := e.remaining(id, amount)
+	if err != nil {
+		return err
+	}
+
+	if err := e.Balance.Release(amount); err != nil {
+		return err
+	}
+
+	h.Released, _ = h.Released.Add(amount)
+	return nil
+}
+
+// Expire releases whatever remains of the expired holds and returns them, ordered by id.
+func (e *Escrow) Expire() ([]*Hold, error) {
+	now := e.now()
+
+	ids := make([]string, 0, len(e.holds))
+	for id := range e.holds {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var expired []*Hold
+	for _, id := range ids {
+		h := e.holds[id]
+		r := h.Remaining()
+		if !h.expired(now) || r.IsZero() {
+			continue
+		}
+
+		if err := e.Release(h.ID, r); err != nil {
+			return expired, err
+		}
+
+		expired = append(expired, h)
+	}
+
+	return expired, nil
+}
+
+// now returns the current time of Now, or of time.Now if it's nil.
+func (e *Escrow) now() time.Time {
+	if e.Now == nil {
+		return time.Now()
+	}
+
+	return e.Now()
+}
+
+// remaining returns the hold with the given id after checking that amount doesn't exceed what remains of it.
+func (e *Escrow) remaining(id string, amount *money.Money) (*Hold, error) {
+	h, ok := e.holds[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrHoldNotFound, id)
+	}
+
+	if amount == nil || !amount.IsPositive() {
+		return nil, ErrInvalidAmount
+	}
+
+	if gt, err := amount.GreaterThan(h.Remaining()); err != nil {
+		return nil, err
+	} else if gt {
+		return nil, fmt.Errorf("%w: '%s' has %s left", ErrOverCapture, id, h.Remaining().Display())
+	}
+
+	return h, nil
+}
@@ -0,0 +1,187 @@
+package ledger
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	money "github.com/bluelabs-eu/go-money"
+)
+
+func newEscrow(t *testing.T, available int64) (*Escrow, *time.Time) {
+	b, _ := NewBalance(money.EUR)
+	if err := b.Deposit(eur(available)); err != nil {
+		t.Fatal(err)
+	}
+
+	now := day(1, 0)
+	e := NewEscrow(b)
+	e.Now = func() time.Time { return now }
+
+	return e, &now
+}
+
+func TestEscrow(t *testing.T) {
+	e, _ := newEscrow(t, 1000)
+
+	h, err := e.Hold("order-1", eur(600), time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	steps := []struct {
+		op                 func(string, *money.Money) error
+		amount             int64
+		remaining          int64
+		available, held    int64
+		captured, released int64
+	}{
+		{e.Capture, 200, 400, 400, 400, 200, 0},
+		{e.Release, 100, 300, 500, 300, 200, 100},
+		{e.Capture, 300, 0, 500, 0, 500, 100},
+	}
+
+	for i, s := range steps {
+		if err := s.op("order-1", eur(s.amount)); err != nil {
+			t.Fatalf("Unexpected error at step %d: %v", i, err)
+		}
+
+		got := []int64{
+			h.Remaining().AmountUnformatted(),
+			e.Balance.Available().AmountUnformatted(),
+			e.Balance.Held().AmountUnformatted(),
+			h.Captured.AmountUnformatted(),
+			h.Released.AmountUnformatted(),
+		}
+		expected := []int64{s.remaining, s.available, s.held, s.captured, s.released}
+		for j := range got {
+			if got[j] != expected[j] {
+				t.Errorf("Expected step %d to leave %v got %v", i, expected, got)
+				break
+			}
+		}
+	}
+
+	if e.Get("order-1") != h || e.Get("order-2") != nil {
+		t.Error("Expected Get to return placed holds only")
+	}
+}
+
+func TestEscrow_Expire(t *testing.T) {
+	e, now := newEscrow(t, 1000)
+
+	_, _ = e.Hold("short", eur(300), day(2, 0))
+	_, _ = e.Hold("long", eur(400), day(5, 0))
+	_, _ = e.Hold("forever", eur(100), time.Time{})
+	_ = e.Capture("short", eur(100))
+
+	*now = day(2, 0)
+	if err := e.Capture("short", eur(1)); !errors.Is(err, ErrHoldExpired) {
+		t.Errorf("Expected %v got %v", ErrHoldExpired, err)
+	}
+
+	expired, err := e.Expire()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(expired) != 1 || expired[0].ID != "short" || !expired[0].Remaining().IsZero() {
+		t.Errorf("Expected only 'short' to expire got %v", expired)
+	}
+
+	if a, h := e.Balance.Available().AmountUnformatted(), e.Balance.Held().AmountUnformatted(); a != 400 || h != 500 {
+		t.Errorf("Expected 400/500 available/held got %d/%d", a, h)
+	}
+
+	if expired, _ := e.Expire(); len(expired) != 0 {
+		t.Errorf("Expected nothing left to expire got %v", expired)
+	}
+
+	*now = day(10, 0)
+	if err := e.Release("long", eur(400)); err != nil {
+		t.Errorf("Expected release of expired hold to succeed got %v", err)
+	}
+}
+
+func TestEscrow_Errors(t *testing.T) {
+	e, _ := newEscrow(t, 1000)
+	_, _ = e.Hold("order-1", eur(500), time.Time{})
+
+	if _, err := e.Hold("order-1", eur(1), time.Time{}); err == nil {
+		t.Error("Expected error for duplicate hold")
+	}
+
+	if _, err := e.Hold("order-2", eur(501), time.Time{}); err != ErrInsufficientFunds {
+		t.Errorf("Expected %v got %v", ErrInsufficientFunds, err)
+	}
+
+	tcs := []struct {
+		op       func(string, *money.Money) error
+		id       string
+		amount   *money.Money
+		expected error
+	}{
+		{e.Capture, "order-1", eur(501), ErrOverCapture},
+		{e.Release, "order-1", eur(501), ErrOverCapture},
+		{e.Capture, "order-2", eur(1), ErrHoldNotFound},
+		{e.Capture, "order-1", usd(1), money.ErrCurrencyMismatch},
+		{e.Capture, "order-1", eur(0), ErrInvalidAmount},
+	}
+
+	for i, tc := range tcs {
+		if err := tc.op(tc.id, tc.amount); !errors.Is(err, tc.expected) {
+			t.Errorf("Expected case %d to fail with %v got %v", i, tc.expected, err)
+		}
+	}
+
+	if r := e.Get("order-1").Remaining().AmountUnformatted(); r != 500 {
+		t.Errorf("Expected failed operations to leave %d got %d", 500, r)
+	}
+}
+
+func TestEscrow_Expire_Order(t *testing.T) {
+	e, now := newEscrow(t, 1000)
+	for _, id := range []string{"d", "b", "e", "a", "c"} {
+		if _, err := e.Hold(id, eur(100), day(2, 0)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	*now = day(3, 0)
+	expired, err := e.Expire()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids string
+	for _, h := range expired {
+		ids += h.ID
+	}
+	if ids != "abcde" {
+		t.Errorf("Expected holds to expire in order abcde got %s", ids)
+	}
+}
+
+func TestEscrow_ZeroValue(t *testing.T) {
+	b, _ := NewBalance(money.EUR)
+	if err := b.Deposit(eur(1000)); err != nil {
+		t.Fatal(err)
+	}
+
+	e := &Escrow{Balance: b}
+	if _, err := e.Hold("order", eur(300), time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := e.Capture("order", eur(100)); err != nil {
+		t.Errorf("Expected capture to succeed got %v", err)
+	}
+
+	if expired, err := e.Expire(); err != nil || len(expired) != 0 {
+		t.Errorf("Expected nothing to expire got %v, %v", expired, err)
+	}
+
+	if a, h := b.Available().AmountUnformatted(), b.Held().AmountUnformatted(); a != 700 || h != 200 {
+		t.Errorf("Expected 700/200 available/held got %d/%d", a, h)
+	}
+}
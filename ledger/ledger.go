@@ -0,0 +1,138 @@
+// Package ledger records money movements as balanced double-entry transactions.
+//
+//	tx := ledger.NewTransaction("tx-1", "card payment").
+//		Debit("assets:psp", gross).
+//		Credit("revenue:sales", net).
+//		Credit("liabilities:vat", vat)
+//	if err := tx.Validate(); err != nil { ... }
+package ledger
+
+import (
+	"errors"
+	"fmt"
+
+	money "github.com/bluelabs-eu/go-money"
+)
+
+// ErrUnbalanced happens when the debits of a transaction don't equal its credits in some currency.
+var ErrUnbalanced = errors.New("unbalanced transaction")
+
+// Account identifies a ledger account, like "assets:bank" or "liabilities:customer:42".
+type Account string
+
+// Side tells whether an entry debits or credits its account.
+type Side int
+
+const (
+	// Debit increases asset and expense accounts and decreases liability, equity and revenue accounts.
+	Debit Side = iota
+	// Credit decreases asset and expense accounts and increases liability, equity and revenue accounts.
+	Credit
+)
+
+func (s Side) String() string {
+	if s == Credit {
+		return "credit"
+	}
+
+	return "debit"
+}
+
+// Entry is one leg of a transaction, moving Amount into or out of Account.
+type Entry struct {
+	Account Account
+	Side    Side
+	Amount  *money.Money
+}
+
+// Transaction is a set of entries whose debits and credits balance in every currency.
+type Transaction struct {
+	ID          string
+	Description string
+	Entries     []Entry
+}
+
+// NewTransaction creates and returns new empty Transaction.
+func NewTransaction(id, description string) *Transaction {
+	return &Transaction{ID: id, Description: description}
+}
+
+// Debit adds an entry debiting account by amount.
+func (t *Transaction) Debit(account Account, amount *money.Money) *Transaction {
+	t.Entries = append(t.Entries, Entry{Account: account, Side: Debit, Amount: amount})
+	return t
+}
+
+// Credit adds an entry crediting account by amount.
+func (t *Transaction) Credit(account Account, amount *money.Money) *Transaction {
+	t.Entries = append(t.Entries, Entry{Account: account, Side: Credit, Amount: amount})
+	return t
+}
+
+// Transfer adds a pair of entries moving amount from one account to another,
+// crediting the former and debiting the latter.
+func (t *Transaction) Transfer(from, to Account, amount *money.Money) *Transaction {
+	return t.Credit(from, amount).Debit(to, amount)
+}
+
+// Validate checks that the transaction has at least two entries, that all amounts are set and not negative,
+// and that debits equal credits in every currency, failing with ErrUnbalanced otherwise.
+func (t *Transaction) Validate() error {
+	if len(t.Entries) < 2 {
+		return fmt.Errorf("transaction '%s' needs at least two entries", t.ID)
+	}
+
+	// Entries are grouped with SameCurrency rather than by currency handle, so that Money created before
+	// and after a currency is overridden still balances.
+	type totals struct {
+		debits, credits *money.Money
+	}
+
+	var groups []*totals
+	for i, e := range t.Entries {
+		if e.Account == "" {
+			return fmt.Errorf("entry %d of transaction '%s' has no account", i, t.ID)
+		}
+
+		if e.Amount == nil {
+			return fmt.Errorf("entry %d of transaction '%s' has no amount", i, t.ID)
+		}
+
+		if e.Amount.IsNegative() {
+			return fmt.Errorf("entry %d of transaction '%s' has negative amount %s", i, t.ID, e.Amount.Display())
+		}
+
+		var s *totals
+		for _, g := range groups {
+			if g.debits.SameCurrency(e.Amount) {
+				s = g
+				break
+			}
+		}
+
+		if s == nil {
+			zero := e.Amount.WithAmount(0)
+			s = &totals{debits: zero, credits: zero}
+			groups = append(groups, s)
+		}
+
+		var err error
+		if e.Side == Credit {
+			s.credits, err = s.credits.AddAll(e.Amount)
+		} else {
+			s.debits, err = s.debits.AddAll(e.Amount)
+		}
+
+		if err != nil {
+			return fmt.Errorf("transaction '%s': %w", t.ID, err)
+		}
+	}
+
+	for _, s := range groups {
+		if eq, _ := s.debits.Equals(s.credits); !eq {
+			return fmt.Errorf("%w '%s': %s debits %s, credits %s", ErrUnbalanced, t.ID, s.debits.CurrencyCode(), s.debits.Display(), s.credits.Display())
+		}
+	}
+
+	return nil
+}
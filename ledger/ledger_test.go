@@ -0,0 +1,91 @@
+package ledger
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	money "github.com/bluelabs-eu/go-money"
+)
+
+func eur(amount int64) *money.Money {
+	m, _ := money.New(amount, money.EUR)
+	return m
+}
+
+func usd(amount int64) *money.Money {
+	m, _ := money.New(amount, money.USD)
+	return m
+}
+
+func TestTransaction_Validate(t *testing.T) {
+	tx := NewTransaction("tx-1", "card payment").
+		Debit("assets:psp", eur(1210)).
+		Credit("revenue:sales", eur(1000)).
+		Credit("liabilities:vat", eur(210))
+
+	if err := tx.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tx = NewTransaction("tx-2", "fx").
+		Transfer("assets:eur", "assets:clearing", eur(1000)).
+		Transfer("assets:clearing", "assets:usd", usd(1080))
+
+	if err := tx.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if len(tx.Entries) != 4 || tx.Entries[0].Side != Credit || tx.Entries[1].Side != Debit {
+		t.Errorf("Expected transfer to credit then debit got %+v", tx.Entries)
+	}
+}
+
+func TestTransaction_Validate_OverriddenCurrency(t *testing.T) {
+	defer money.Restore(money.Snapshot())
+
+	before := eur(100)
+	c := *money.GetCurrency(money.EUR)
+	c.Grapheme = "EUR "
+	if err := money.OverrideCurrency(c, false); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := NewTransaction("tx-1", "").Debit("x", before).Credit("y", eur(100))
+	if err := tx.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	micros, _ := money.NewFromMicros(1000000, money.EUR)
+	tx = NewTransaction("tx-2", "").Debit("x", micros).Credit("y", eur(100))
+	if err := tx.Validate(); !errors.Is(err, ErrUnbalanced) {
+		t.Errorf("Expected %v got %v", ErrUnbalanced, err)
+	}
+}
+
+func TestTransaction_Validate_Errors(t *testing.T) {
+	tcs := []struct {
+		tx       *Transaction
+		expected string
+	}{
+		{NewTransaction("a", "").Debit("x", eur(1)), "transaction 'a' needs at least two entries"},
+		{NewTransaction("b", "").Debit("x", eur(1)).Credit("y", eur(2)), "unbalanced transaction 'b': EUR debits €0.01, credits €0.02"},
+		{NewTransaction("c", "").Debit("x", eur(1)).Credit("y", usd(1)), "unbalanced transaction 'c': EUR debits €0.01, credits €0.00"},
+		{NewTransaction("d", "").Debit("x", eur(-1)).Credit("y", eur(-1)), "entry 0 of transaction 'd' has negative amount -€0.01"},
+		{NewTransaction("e", "").Debit("x", nil).Credit("y", eur(1)), "entry 0 of transaction 'e' has no amount"},
+		{NewTransaction("f", "").Debit("", eur(1)).Credit("y", eur(1)), "entry 0 of transaction 'f' has no account"},
+		{NewTransaction("g", "").Debit("x", eur(math.MaxInt64)).Debit("x", eur(1)).Credit("y", eur(1)), "transaction 'g': amount overflow"},
+	}
+
+	for _, tc := range tcs {
+		err := tc.tx.Validate()
+		if err == nil || err.Error() != tc.expected {
+			t.Errorf("Expected %q got %v", tc.expected, err)
+		}
+	}
+
+	err := NewTransaction("h", "").Debit("x", eur(1)).Credit("y", eur(2)).Validate()
+	if !errors.Is(err, ErrUnbalanced) {
+		t.Errorf("Expected %v got %v", ErrUnbalanced, err)
+	}
+}
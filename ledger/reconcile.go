@@ -0,0 +1,122 @@
+package ledger
+
+import (
+	"fmt"
+
+	money "github.com/bluelabs-eu/go-money"
+)
+
+// Record is an identified amount of one side of a reconciliation, like a settlement line of a PSP report
+// or a payment booked internally.
+type Record struct {
+	ID     string
+	Amount *money.Money
+}
+
+// Mismatch is a record found on both sides with different amounts.
+type Mismatch struct {
+	ID       string
+	Expected *money.Money
+	Actual   *money.Money
+	// Difference is the actual minus the expected amount, nil when their currencies differ.
+	Difference *money.Money
+}
+
+// Reconciliation is the outcome of Reconcile, with the totals of every category per currency.
+type Reconciliation struct {
+	Matched    []Record
+	Missing    []Record
+	Extra      []Record
+	Mismatched []Mismatch
+
+	MatchedTotal  money.Amounts
+	MissingTotal  money.Amounts
+	ExtraTotal    money.Amounts
+	MismatchTotal money.Amounts
+}
+
+// IsBalanced returns boolean of whether both sides matched completely.
+func (r *Reconciliation) IsBalanced() bool {
+	return len(r.Missing) == 0 && len(r.Extra) == 0 && len(r.Mismatched) == 0
+}
+
+// Reconcile matches the expected records with the actual ones by ID. Expected records not found are missing,
+// actual records not expected are extra, and records whose amounts differ, in value or currency, are mismatched.
+// Matched, missing and mismatched records keep the order of expected, extra records the order of actual.
+// The mismatch total sums the differences which could be computed.
+func Reconcile(expected, actual []Record) (*Reconciliation, error) {
+	byID, err := index("actual", actual)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := index("expected", expected); err != nil {
+		return nil, err
+	}
+
+	r := &Reconciliation{}
+	for _, e := range expected {
+		i, ok := byID[e.ID]
+		if !ok {
+			r.Missing = append(r.Missing, e)
+			if err := r.MissingTotal.Add(e.Amount); err != nil {
+				return nil, fmt.Errorf("record '%s': %w", e.ID, err)
+			}
+			continue
+		}
+
+		a := actual[i]
+		delete(byID, e.ID)
+
+		if eq, _ := a.Amount.Equals(e.Amount); eq {
+			r.Matched = append(r.Matched, e)
+			if err := r.MatchedTotal.Add(e.Amount); err != nil {
+				return nil, fmt.Errorf("record '%s': %w", e.ID, err)
+			}
+			continue
+		}
+
+		m := Mismatch{ID: e.ID, Expected: e.Amount, Actual: a.Amount}
+		if a.Amount.SameCurrency(e.Amount) {
+			if m.Difference, err = a.Amount.SubtractAll(e.Amount); err != nil {
+				return nil, fmt.Errorf("record '%s': %w", e.ID, err)
+			}
+
+			if err := r.MismatchTotal.Add(m.Difference); err != nil {
+				return nil, fmt.Errorf("record '%s': %w", e.ID, err)
+			}
+		}
+		r.Mismatched = append(r.Mismatched, m)
+	}
+
+	for _, a := range actual {
+		if _, ok := byID[a.ID]; !ok {
+			continue
+		}
+
+		r.Extra = append(r.Extra, a)
+		if err := r.ExtraTotal.Add(a.Amount); err != nil {
+			return nil, fmt.Errorf("record '%s': %w", a.ID, err)
+		}
+	}
+
+	return r, nil
+}
+
+// index returns the positions of the records by ID, failing for duplicate IDs and records without amount.
+func index(side string, records []Record) (map[string]int, error) {
+	byID := make(map[string]int, len(records))
+	for i, r := range records {
+		if r.Amount == nil {
+			return nil, fmt.Errorf("%s record '%s' has no amount", side, r.ID)
+		}
+
+		if _, ok := byID[r.ID]; ok {
+			return nil, fmt.Errorf("duplicate %s record '%s'", side, r.ID)
+		}
+
+		byID[r.ID] = i
+	}
+
+	return byID, nil
+}
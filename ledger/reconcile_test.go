@@ -0,0 +1,110 @@
+package ledger
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	money "github.com/bluelabs-eu/go-money"
+)
+
+func TestReconcile(t *testing.T) {
+	expected := []Record{
+		{"p-1", eur(1000)},
+		{"p-2", eur(2500)},
+		{"p-3", usd(700)},
+		{"p-4", eur(300)},
+		{"p-5", eur(100)},
+	}
+
+	actual := []Record{
+		{"p-5", usd(100)},
+		{"s-9", eur(50)},
+		{"p-2", eur(2450)},
+		{"p-1", eur(1000)},
+		{"p-3", usd(700)},
+		{"s-8", usd(20)},
+	}
+
+	r, err := Reconcile(expected, actual)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := func(rs []Record) (s []string) {
+		for _, r := range rs {
+			s = append(s, r.ID)
+		}
+		return s
+	}
+
+	if got := ids(r.Matched); len(got) != 2 || got[0] != "p-1" || got[1] != "p-3" {
+		t.Errorf("Expected p-1 and p-3 to match got %v", got)
+	}
+
+	if got := ids(r.Missing); len(got) != 1 || got[0] != "p-4" {
+		t.Errorf("Expected p-4 to be missing got %v", got)
+	}
+
+	if got := ids(r.Extra); len(got) != 2 || got[0] != "s-9" || got[1] != "s-8" {
+		t.Errorf("Expected s-9 and s-8 to be extra got %v", got)
+	}
+
+	if len(r.Mismatched) != 2 || r.Mismatched[0].ID != "p-2" || r.Mismatched[0].Difference.Display() != "-€0.50" ||
+		r.Mismatched[1].ID != "p-5" || r.Mismatched[1].Difference != nil {
+		t.Errorf("Expected p-2 and p-5 to mismatch got %+v", r.Mismatched)
+	}
+
+	totals := []struct {
+		name     string
+		amounts  money.Amounts
+		code     string
+		expected string
+	}{
+		{"matched", r.MatchedTotal, money.EUR, "€10.00"},
+		{"matched", r.MatchedTotal, money.USD, "$7.00"},
+		{"missing", r.MissingTotal, money.EUR, "€3.00"},
+		{"extra", r.ExtraTotal, money.EUR, "€0.50"},
+		{"extra", r.ExtraTotal, money.USD, "$0.20"},
+		{"mismatch", r.MismatchTotal, money.EUR, "-€0.50"},
+	}
+
+	for _, tc := range totals {
+		if m := tc.amounts.Get(tc.code); m == nil || m.Display() != tc.expected {
+			t.Errorf("Expected %s total of %s to be %s got %v", tc.name, tc.code, tc.expected, m)
+		}
+	}
+
+	if r.IsBalanced() {
+		t.Error("Expected reconciliation not to be balanced")
+	}
+
+	r, err = Reconcile(expected[:1], []Record{{"p-1", eur(1000)}})
+	if err != nil || !r.IsBalanced() {
+		t.Errorf("Expected reconciliation to be balanced got %+v, %v", r, err)
+	}
+}
+
+func TestReconcile_Errors(t *testing.T) {
+	tcs := []struct {
+		expected []Record
+		actual   []Record
+		err      string
+	}{
+		{[]Record{{"a", eur(1)}, {"a", eur(2)}}, nil, "duplicate expected record 'a'"},
+		{nil, []Record{{"a", eur(1)}, {"a", eur(2)}}, "duplicate actual record 'a'"},
+		{[]Record{{"a", nil}}, nil, "expected record 'a' has no amount"},
+		{nil, []Record{{"b", nil}}, "actual record 'b' has no amount"},
+	}
+
+	for _, tc := range tcs {
+		if _, err := Reconcile(tc.expected, tc.actual); err == nil || err.Error() != tc.err {
+			t.Errorf("Expected %q got %v", tc.err, err)
+		}
+	}
+
+	_, err := Reconcile([]Record{{"a", eur(math.MaxInt64)}, {"b", eur(1)}}, nil)
+	if !errors.Is(err, money.ErrOverflow) {
+		t.Errorf("Expected %v got %v", money.ErrOverflow, err)
+	}
+}
@@ -0,0 +1,80 @@
+package ledger
+
+import (
+	"fmt"
+	"time"
+
+	money "github.com/bluelabs-eu/go-money"
+)
+
+// StatementEntry is a signed movement on a statement, positive for money coming in and negative for money going out.
+type StatementEntry struct {
+	Date        time.Time
+	Description string
+	Amount      *money.Money
+}
+
+// StatementLine is an entry along with the balance right after it.
+type StatementLine struct {
+	StatementEntry
+	Balance *money.Money
+}
+
+// DaySubtotal sums the entries of one calendar day, in the location of their dates.
+type DaySubtotal struct {
+	Day     time.Time
+	Total   *money.Money
+	Closing *money.Money
+}
+
+// Statement lists entries with running balances from an opening to a closing balance.
+type Statement struct {
+	Opening *money.Money
+	Closing *money.Money
+	Lines   []StatementLine
+	Days    []DaySubtotal
+}
+
+// NewStatement applies entries in order to the opening balance and returns the resulting Statement.
+// Entries must be sorted by date and be in the currency of the opening balance, failing with
+// money.ErrCurrencyMismatch otherwise. A balance or subtotal out of range fails with money.ErrOverflow.
+func NewStatement(opening *money.Money, entries []StatementEntry) (*Statement, error) {
+	if opening == nil {
+		return nil, fmt.Errorf("statement has no opening balance")
+	}
+
+	s := &Statement{Opening: opening, Closing: opening, Lines: make([]StatementLine, 0, len(entries))}
+	zero := opening.WithAmount(0)
+
+	for i, e := range entries {
+		if e.Amount == nil {
+			return nil, fmt.Errorf("statement entry %d has no amount", i)
+		}
+
+		if i > 0 && e.Date.Before(entries[i-1].Date) {
+			return nil, fmt.Errorf("statement entry %d is dated before the previous entry", i)
+		}
+
+		balance, err := s.Closing.AddAll(e.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("statement entry %d: %w", i, err)
+		}
+
+		y, m, d := e.Date.Date()
+		day := time.Date(y, m, d, 0, 0, 0, 0, e.Date.Location())
+		if n := len(s.Days); n == 0 || !s.Days[n-1].Day.Equal(day) {
+			s.Days = append(s.Days, DaySubtotal{Day: day, Total: zero})
+		}
+
+		sub := &s.Days[len(s.Days)-1]
+		if sub.Total, err = sub.Total.AddAll(e.Amount); err != nil {
+			return nil, fmt.Errorf("statement entry %d: %w", i, err)
+		}
+
+		sub.Closing = balance
+		s.Closing = balance
+		s.Lines = append(s.Lines, StatementLine{StatementEntry: e, Balance: balance})
+	}
+
+	return s, nil
+}
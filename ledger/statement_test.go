@@ -0,0 +1,91 @@
+package ledger
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	money "github.com/bluelabs-eu/go-money"
+)
+
+func day(d, h int) time.Time {
+	return time.Date(2024, time.March, d, h, 0, 0, 0, time.UTC)
+}
+
+func TestNewStatement(t *testing.T) {
+	s, err := NewStatement(eur(1000), []StatementEntry{
+		{day(1, 9), "salary", eur(5000)},
+		{day(1, 18), "rent", eur(-3000)},
+		{day(3, 12), "groceries", eur(-250)},
+		{day(4, 8), "refund", eur(50)},
+		{day(4, 20), "coffee", eur(-300)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	balances := []int64{6000, 3000, 2750, 2800, 2500}
+	for i, l := range s.Lines {
+		if l.Balance.AmountUnformatted() != balances[i] {
+			t.Errorf("Expected balance %d after line %d got %d", balances[i], i, l.Balance.AmountUnformatted())
+		}
+	}
+
+	days := []struct {
+		day            time.Time
+		total, closing int64
+	}{
+		{day(1, 0), 2000, 3000},
+		{day(3, 0), -250, 2750},
+		{day(4, 0), -250, 2500},
+	}
+
+	if len(s.Days) != len(days) {
+		t.Fatalf("Expected %d days got %d", len(days), len(s.Days))
+	}
+
+	for i, d := range days {
+		sd := s.Days[i]
+		if !sd.Day.Equal(d.day) || sd.Total.AmountUnformatted() != d.total || sd.Closing.AmountUnformatted() != d.closing {
+			t.Errorf("Expected %v %d/%d got %v %d/%d", d.day, d.total, d.closing, sd.Day, sd.Total.AmountUnformatted(), sd.Closing.AmountUnformatted())
+		}
+	}
+
+	if s.Opening.AmountUnformatted() != 1000 || s.Closing.AmountUnformatted() != 2500 {
+		t.Errorf("Expected opening %d and closing %d got %v and %v", 1000, 2500, s.Opening, s.Closing)
+	}
+
+	empty, err := NewStatement(eur(1000), nil)
+	if err != nil || empty.Closing.AmountUnformatted() != 1000 || len(empty.Days) != 0 {
+		t.Errorf("Expected empty statement closing at %d got %v, %v", 1000, empty, err)
+	}
+}
+
+func TestNewStatement_Errors(t *testing.T) {
+	tcs := []struct {
+		opening  *money.Money
+		entries  []StatementEntry
+		expected error
+	}{
+		{eur(0), []StatementEntry{{day(1, 0), "", eur(1)}, {day(1, 1), "", usd(1)}}, money.ErrCurrencyMismatch},
+		{eur(math.MaxInt64), []StatementEntry{{day(1, 0), "", eur(1)}}, money.ErrOverflow},
+		{eur(math.MinInt64), []StatementEntry{{day(1, 0), "", eur(-1)}}, money.ErrOverflow},
+		{eur(-100), []StatementEntry{{day(1, 0), "", eur(math.MaxInt64)}, {day(1, 1), "", eur(50)}}, money.ErrOverflow},
+		{eur(0), []StatementEntry{{day(2, 0), "", eur(1)}, {day(1, 0), "", eur(1)}}, nil},
+		{eur(0), []StatementEntry{{day(1, 0), "", nil}}, nil},
+		{nil, nil, nil},
+	}
+
+	for i, tc := range tcs {
+		_, err := NewStatement(tc.opening, tc.entries)
+		if err == nil {
+			t.Errorf("Expected error for case %d", i)
+			continue
+		}
+
+		if tc.expected != nil && !errors.Is(err, tc.expected) {
+			t.Errorf("Expected case %d to fail with %v got %v", i, tc.expected, err)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package money
+
+import (
+	"fmt"
+)
+
+// MicrosFraction is the number of decimal places of amounts in micros, i.e. millionths of a major unit,
+// as used by ad-tech and FX systems.
+const MicrosFraction = 6
+
+// NewFromMicros creates and returns new instance of Money from an amount in micros,
+// e.g. 1250000 USD micros represents $1.25. The resulting Money can accrue fractions of the
+// currency's smallest unit and has to be converted back with Settle before being mixed with Money
+// in the currency's standard fraction.
+func NewFromMicros(micros int64, currencyCode string) (*Money, error) {
+	currency := GetCurrency(currencyCode)
+	if currency == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", currencyCode)
+	}
+
+	return &Money{
+		amount:   micros,
+		currency: currency.withFraction(MicrosFraction),
+	}, nil
+}
+
+// ToMicros returns new Money struct with value converted to micros.
+// Currencies with more than six decimal places are rounded half away from zero.
+func (m *Money) ToMicros() (*Money, error) {
+	return m.Rescale(MicrosFraction, RoundHalfUp)
+}
+
+// IsMicros returns boolean of whether Money is represented in micros.
+func (m *Money) IsMicros() bool {
+	return m.currency.get().Fraction == MicrosFraction
+}
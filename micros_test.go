@@ -0,0 +1,77 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestNewFromMicros(t *testing.T) {
+	m, err := NewFromMicros(1250000, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.amount != 1250000 || !m.IsMicros() {
+		t.Errorf("Expected %d micros got %d", 1250000, m.amount)
+	}
+
+	if m.Display() != "$1.250000" {
+		t.Errorf("Expected %s got %s", "$1.250000", m.Display())
+	}
+
+	if _, err := NewFromMicros(1, "UNKNOWN"); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+}
+
+func TestMoney_ToMicros(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected int64
+	}{
+		{125, USD, 1250000},
+		{-1, EUR, -10000},
+		{5, JPY, 5000000},
+		{1, BHD, 1000},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		r, err := m.ToMicros()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.amount != tc.expected || !r.IsMicros() {
+			t.Errorf("Expected %d %s to be %d micros got %d", tc.amount, tc.code, tc.expected, r.amount)
+		}
+	}
+}
+
+func TestMoney_Settle(t *testing.T) {
+	// Accrue 0.4 cents three times, which would be lost if every accrual was rounded.
+	accrual, _ := NewFromMicros(4000, USD)
+	total, _ := NewFromMicros(0, USD)
+	for i := 0; i < 3; i++ {
+		total, _ = total.Add(accrual)
+	}
+
+	r, err := total.Settle(RoundHalfUp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.amount != 1 || r.IsMicros() || r.currency != GetCurrency(USD) {
+		t.Errorf("Expected settled amount of %d got %d", 1, r.amount)
+	}
+
+	r, _ = total.Settle(RoundDown)
+	if r.amount != 1 {
+		t.Errorf("Expected settled amount of %d got %d", 1, r.amount)
+	}
+
+	usd, _ := New(100, USD)
+	if _, err := total.Add(usd); err == nil {
+		t.Error("Expected error adding micros to standard Money")
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/rand"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
 // Injection points for backward compatibility.
@@ -24,32 +26,31 @@ var (
 	// ErrCurrencyMismatch happens when two compared Money don't have the same currency.
 	ErrCurrencyMismatch = errors.New("currencies don't match")
 
+	// ErrOverflow happens when the result of an operation doesn't fit into an Amount.
+	ErrOverflow = errors.New("amount overflow")
+
+	// ErrInexactDivision happens when Money can't be divided without a remainder.
+	ErrInexactDivision = errors.New("amount not exactly divisible")
+
+	// ErrBelowMinimum happens when Money can't be divided without a party receiving less than the minimum.
+	ErrBelowMinimum = errors.New("amount below minimum per party")
+
 	// ErrInvalidJSON happens when the default money.UnmarshalJSON fails to unmarshal Money because of invalid data.
 	ErrInvalidJSON = errors.New("invalid json")
 )
 
 func unmarshalJSON(m *Money, b []byte) error {
-	data := make(map[string]interface{})
+	var data struct {
+		Amount   jsonString `json:"amount"`
+		Currency jsonString `json:"currency"`
+	}
+
 	err := json.Unmarshal(b, &data)
 	if err != nil {
 		return err
 	}
 
-	var amount string
-	if amountRaw, ok := data["amount"]; ok {
-		amount, ok = amountRaw.(string)
-		if !ok {
-			return ErrInvalidJSON
-		}
-	}
-
-	var currency string
-	if currencyRaw, ok := data["currency"]; ok {
-		currency, ok = currencyRaw.(string)
-		if !ok {
-			return ErrInvalidJSON
-		}
-	}
+	amount, currency := string(data.Amount), normalizeCurrencyCode(string(data.Currency))
 
 	var ref *Money
 	if amount == "" && currency == "" {
@@ -66,6 +67,31 @@ func unmarshalJSON(m *Money, b []byte) error {
 	return nil
 }
 
+// normalizeCurrencyCode returns a currency code sent by a sloppy upstream system in upper case,
+// without surrounding whitespace and the trailing nulls of fixed-width fields.
+func normalizeCurrencyCode(code string) string {
+	code = strings.TrimRightFunc(code, func(r rune) bool { return r == 0 || unicode.IsSpace(r) })
+	return strings.ToUpper(strings.TrimLeftFunc(code, unicode.IsSpace))
+}
+
+// jsonString is a JSON string decoded without going through interface{}.
+type jsonString string
+
+// UnmarshalJSON is implementation of json.Unmarshaller, failing with ErrInvalidJSON for anything but a string.
+// Strings without escape sequences, like all amounts and currency codes, are taken as they are.
+func (s *jsonString) UnmarshalJSON(b []byte) error {
+	if len(b) < 2 || b[0] != '"' {
+		return ErrInvalidJSON
+	}
+
+	if bytes.IndexByte(b, '\\') == -1 {
+		*s = jsonString(b[1 : len(b)-1])
+		return nil
+	}
+
+	return json.Unmarshal(b, (*string)(s))
+}
+
 func marshalJSON(m Money) ([]byte, error) {
 	if m == (Money{}) {
 		m = Money{0, newCurrency("").get()}
@@ -80,6 +106,10 @@ type Amount = int64
 
 // Money represents monetary value information, stores
 // currency and amount value.
+// The currency is an interned handle shared by all Money of the same currency and fraction, which stays the same
+// when the currency is registered again with AddCurrency, OverrideCurrency or Restore. Money values are small,
+// cheap to copy, and can be compared with == and used as map keys.
+// Operations return new Money structs and leave their operands untouched, except for the *Assign methods.
 type Money struct {
 	amount   Amount
 	currency *Currency
@@ -159,6 +189,10 @@ func NewFromString(amount string, currencyCode string) (*Money, error) {
 
 // Currency returns the currency used by Money.
 func (m *Money) CurrencyCode() string {
+	if m.currency == nil {
+		return ""
+	}
+
 	return m.currency.Code
 }
 
@@ -167,9 +201,42 @@ func (m *Money) AmountUnformatted() int64 {
 	return m.amount
 }
 
+// Clone returns new Money struct with the same value and currency.
+func (m *Money) Clone() *Money {
+	return &Money{amount: m.amount, currency: m.currency}
+}
+
+// WithAmount returns new Money struct with the given amount, in the currency's smallest unit, and the same currency.
+func (m *Money) WithAmount(amount int64) *Money {
+	return &Money{amount: amount, currency: m.currency}
+}
+
+// WithCurrency returns new Money struct with the same amount, in the smallest unit, and the given currency.
+// The amount isn't converted, use Convert to exchange Money into another currency.
+func (m *Money) WithCurrency(currencyCode string) (*Money, error) {
+	return New(m.amount, currencyCode)
+}
+
+// Amount returns the formatted amount without the currency template, like "12.34", using the separators of
+// the currency. It's the amount of the JSON, CSV and SQL encodings, so formatters registered with
+// RegisterFormatter don't change it. Currencies using "." as decimal and no thousand separator, as all listed
+// ones do, take a fast path writing the digits directly.
 func (m *Money) Amount() string {
-	currency := m.currency.get()
-	return currency.Formatter().FormatAmount(m.amount)
+	c := m.currency.get()
+	if c.Decimal == "." && c.Thousand == "" {
+		return m.AmountFixed()
+	}
+
+	f := Formatter{Fraction: c.Fraction, Decimal: c.Decimal, Thousand: c.Thousand}
+	return f.FormatAmount(m.amount)
+}
+
+// AmountFixed returns the amount as a normalized decimal number for APIs and files, like "1234567.89":
+// "." as decimal separator, no thousand separator and always all of the currency's decimal places,
+// regardless of how the currency is displayed.
+func (m *Money) AmountFixed() string {
+	var buf [40]byte
+	return string(appendFixed(buf[:0], m.amount, m.currency.get().Fraction))
 }
 
 // SameCurrency check if given Money is equals by currency.
@@ -205,6 +272,24 @@ func (m *Money) Equals(om *Money) (bool, error) {
 	return m.compare(om) == 0, nil
 }
 
+// EqualsWithin checks whether two Money types differ by no more than tolerance,
+// e.g. to accept rounding differences between systems during reconciliation.
+func (m *Money) EqualsWithin(om, tolerance *Money) (bool, error) {
+	if err := m.assertSameCurrency(om); err != nil {
+		return false, err
+	}
+
+	if err := m.assertSameCurrency(tolerance); err != nil {
+		return false, err
+	}
+
+	if tolerance.IsNegative() {
+		return false, errors.New("tolerance must not be negative")
+	}
+
+	return mutate.calc.absolute(mutate.calc.subtract(m.amount, om.amount)) <= tolerance.amount, nil
+}
+
 // GreaterThan checks whether the value of Money is greater than the other.
 func (m *Money) GreaterThan(om *Money) (bool, error) {
 	if err := m.assertSameCurrency(om); err != nil {
@@ -241,6 +326,53 @@ func (m *Money) LessThanOrEqual(om *Money) (bool, error) {
 	return m.compare(om) <= 0, nil
 }
 
+// Between checks whether the value of Money lies between min and max,
+// including both bounds if inclusive is true.
+func (m *Money) Between(min, max *Money, inclusive bool) (bool, error) {
+	if err := m.assertSameCurrency(min); err != nil {
+		return false, err
+	}
+
+	if err := m.assertSameCurrency(max); err != nil {
+		return false, err
+	}
+
+	if min.compare(max) == 1 {
+		return false, errors.New("min must not be greater than max")
+	}
+
+	if inclusive {
+		return m.compare(min) >= 0 && m.compare(max) <= 0, nil
+	}
+
+	return m.compare(min) == 1 && m.compare(max) == -1, nil
+}
+
+// Clamp returns new Money struct with value bounded to the range between min and max, both inclusive.
+func (m *Money) Clamp(min, max *Money) (*Money, error) {
+	if err := m.assertSameCurrency(min); err != nil {
+		return nil, err
+	}
+
+	if err := m.assertSameCurrency(max); err != nil {
+		return nil, err
+	}
+
+	if min.compare(max) == 1 {
+		return nil, errors.New("min must not be greater than max")
+	}
+
+	a := m.amount
+	switch {
+	case m.compare(min) == -1:
+		a = min.amount
+	case m.compare(max) == 1:
+		a = max.amount
+	}
+
+	return &Money{amount: a, currency: m.currency}, nil
+}
+
 // IsZero returns boolean of whether the value of Money is equals to zero.
 func (m *Money) IsZero() bool {
 	return m.amount == 0
@@ -284,101 +416,546 @@ func (m *Money) Subtract(om *Money) (*Money, error) {
 	return &Money{amount: mutate.calc.subtract(m.amount, om.amount), currency: m.currency}, nil
 }
 
+// AddAll returns new Money struct with value representing sum of Self and all others.
+// Currencies are checked before adding anything, and the sum fails with ErrOverflow instead of wrapping around.
+func (m *Money) AddAll(others ...*Money) (*Money, error) {
+	return m.sumAll(mutate.calc.addChecked, others)
+}
+
+// SubtractAll returns new Money struct with value representing Self minus all others.
+// Currencies are checked before subtracting anything, and the difference fails with ErrOverflow instead of wrapping around.
+func (m *Money) SubtractAll(others ...*Money) (*Money, error) {
+	return m.sumAll(mutate.calc.subtractChecked, others)
+}
+
+func (m *Money) sumAll(op func(a, b Amount) (Amount, error), others []*Money) (*Money, error) {
+	for _, om := range others {
+		if err := m.assertSameCurrency(om); err != nil {
+			return nil, err
+		}
+	}
+
+	a := m.amount
+	for _, om := range others {
+		var err error
+		if a, err = op(a, om.amount); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Money{amount: a, currency: m.currency}, nil
+}
+
 // Multiply returns new Money struct with value representing Self multiplied value by multiplier.
 func (m *Money) Multiply(mul int64) *Money {
 	return &Money{amount: mutate.calc.multiply(m.amount, mul), currency: m.currency}
 }
 
+// AddAssign adds om to Self in place instead of returning new Money struct, avoiding an allocation
+// per operation when summing many amounts. Any other reference to Self observes the change,
+// so it must only be used on Money owned exclusively by the caller, e.g. one obtained from Clone.
+// On error Self is left unchanged.
+func (m *Money) AddAssign(om *Money) error {
+	if err := m.assertSameCurrency(om); err != nil {
+		return err
+	}
+
+	m.amount = mutate.calc.add(m.amount, om.amount)
+	return nil
+}
+
+// SubtractAssign subtracts om from Self in place, see AddAssign for the trade-offs.
+func (m *Money) SubtractAssign(om *Money) error {
+	if err := m.assertSameCurrency(om); err != nil {
+		return err
+	}
+
+	m.amount = mutate.calc.subtract(m.amount, om.amount)
+	return nil
+}
+
+// MultiplyAssign multiplies Self by mul in place, see AddAssign for the trade-offs.
+func (m *Money) MultiplyAssign(mul int64) {
+	m.amount = mutate.calc.multiply(m.amount, mul)
+}
+
+// MultiplyRational returns new Money struct with value representing Self multiplied by numerator/denominator,
+// rounded using mode. The intermediate product can't overflow, only a result not fitting into an Amount returns ErrOverflow.
+func (m *Money) MultiplyRational(numerator, denominator int64, mode RoundingMode) (*Money, error) {
+	if denominator == 0 {
+		return nil, errors.New("division by zero")
+	}
+
+	if err := mode.validate(); err != nil {
+		return nil, err
+	}
+
+	a, err := mutate.calc.mulDivRound(m.amount, numerator, denominator, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: a, currency: m.currency}, nil
+}
+
+// MultiplyFloat returns new Money struct with value representing Self multiplied by f, rounded using mode.
+// The multiplier is taken as the shortest decimal representing f, so 1.15 multiplies by exactly 115/100
+// instead of the binary approximation of 1.15.
+func (m *Money) MultiplyFloat(f float64, mode RoundingMode) (*Money, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, errors.New("multiplier must be a finite number")
+	}
+
+	r, err := ParseRate(strconv.FormatFloat(f, 'f', -1, 64))
+	if err != nil {
+		return nil, err
+	}
+
+	return m.MultiplyRational(r.Numerator, r.Denominator, mode)
+}
+
+// DivideExact returns new Money struct with value representing Self divided by divisor,
+// failing with ErrInexactDivision instead of dropping any remainder.
+func (m *Money) DivideExact(divisor int64) (*Money, error) {
+	q, r, err := m.DivMod(divisor)
+	if err != nil {
+		return nil, err
+	}
+
+	if !r.IsZero() {
+		return nil, ErrInexactDivision
+	}
+
+	return q, nil
+}
+
+// DivMod returns new Money structs with the quotient and the remainder of Self divided by divisor,
+// so that quotient multiplied by divisor plus remainder always equals Self.
+// The division truncates towards zero, so the remainder has the same sign as Self.
+func (m *Money) DivMod(divisor int64) (*Money, *Money, error) {
+	if divisor == 0 {
+		return nil, nil, errors.New("division by zero")
+	}
+
+	return &Money{amount: mutate.calc.divide(m.amount, divisor), currency: m.currency},
+		&Money{amount: mutate.calc.modulus(m.amount, divisor), currency: m.currency}, nil
+}
+
+// Percent returns new Money struct with value representing the given Rate of Self,
+// rounded half away from zero to the currency's smallest unit. It fails with ErrOverflow
+// when the result doesn't fit into an Amount.
+func (m *Money) Percent(r Rate) (*Money, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	a, err := r.apply(m.amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: a, currency: m.currency}, nil
+}
+
 // Round returns new Money struct with value rounded to nearest zero.
 func (m *Money) Round() *Money {
-	return &Money{amount: mutate.calc.round(m.amount, m.currency.Fraction), currency: m.currency}
+	return &Money{amount: mutate.calc.round(m.amount, m.currency.get().Fraction), currency: m.currency}
+}
+
+// Rescale returns new Money struct with value converted to the given number of decimal places,
+// e.g. from a 4 decimal internal ledger to the 2 decimals of the currency. Precision lost when
+// decreasing the fraction is rounded using mode.
+//
+// The resulting Money keeps its currency code, but isn't considered the same currency as Money
+// using a different fraction, so they can't be mixed up in arithmetic by accident.
+func (m *Money) Rescale(fraction int, mode RoundingMode) (*Money, error) {
+	if fraction < 0 {
+		return nil, errors.New("fraction must not be negative")
+	}
+
+	if err := mode.validate(); err != nil {
+		return nil, err
+	}
+
+	c := m.currency.get()
+	a := m.amount
+	for f := c.Fraction; f < fraction; f++ {
+		if mutate.calc.absolute(a) > math.MaxInt64/10 {
+			return nil, errors.New("amount overflows when rescaled")
+		}
+		a = mutate.calc.multiply(a, 10)
+	}
+
+	if fraction < c.Fraction {
+		a = mutate.calc.divideRound(a, int64(math.Pow10(c.Fraction-fraction)), mode)
+	}
+
+	return &Money{amount: a, currency: c.withFraction(fraction)}, nil
+}
+
+// Settle returns new Money struct with value converted back to the fraction of its currency,
+// e.g. after accruing amounts in micros. Precision lost is rounded using mode.
+func (m *Money) Settle(mode RoundingMode) (*Money, error) {
+	return m.Rescale(newCurrency(m.CurrencyCode()).get().Fraction, mode)
+}
+
+// CashRound returns new Money struct with value rounded to the smallest cash denomination of its currency,
+// e.g. to 0.05 for CHF or to 1.00 for SEK. Halves are rounded away from zero.
+// Currencies without cash rounding metadata are returned unchanged. Amounts rounding beyond the range of
+// an Amount fail with ErrOverflow.
+func (m *Money) CashRound() (*Money, error) {
+	step := m.currency.get().CashRounding
+	if step <= 1 {
+		return &Money{amount: m.amount, currency: m.currency}, nil
+	}
+
+	a, err := mutate.calc.roundTo(m.amount, step)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: a, currency: m.currency}, nil
 }
 
 // Split returns slice of Money structs with split Self value in given number.
 // After division leftover pennies will be distributed round-robin amongst the parties.
 // This means that parties listed first will likely receive more pennies than ones that are listed later.
 func (m *Money) Split(n int) ([]*Money, error) {
+	return m.SplitWithStrategy(n, RemainderRoundRobin)
+}
+
+// SplitWithStrategy works like Split, distributing leftover pennies according to the given strategy.
+func (m *Money) SplitWithStrategy(n int, strategy RemainderStrategy) ([]*Money, error) {
 	if n <= 0 {
 		return nil, errors.New("split must be higher than zero")
 	}
 
-	a := mutate.calc.divide(m.amount, int64(n))
-	ms := make([]*Money, n)
+	as, err := mutate.calc.distribute(m.amount, n, strategy)
+	if err != nil {
+		return nil, err
+	}
 
-	for i := 0; i < n; i++ {
-		ms[i] = &Money{amount: a, currency: m.currency}
+	return m.parts(as), nil
+}
+
+// SplitRandom works like Split, giving leftover pennies to randomly picked parties, at most one penny each.
+// The same seed always picks the same parties.
+func (m *Money) SplitRandom(n int, seed int64) ([]*Money, error) {
+	if n <= 0 {
+		return nil, errors.New("split must be higher than zero")
+	}
+
+	as, err := mutate.calc.distribute(m.amount, n, RemainderRoundRobin)
+	if err != nil {
+		return nil, err
 	}
 
-	r := mutate.calc.modulus(m.amount, int64(n))
-	l := mutate.calc.absolute(r)
-	// Add leftovers to the first parties.
+	// Parties receiving a penny are at the front, shuffling moves them to random places.
+	rand.New(rand.NewSource(seed)).Shuffle(n, func(i, j int) {
+		as[i], as[j] = as[j], as[i]
+	})
 
-	v := int64(1)
-	if m.amount < 0 {
-		v = -1
+	return m.parts(as), nil
+}
+
+// SplitWithCap splits Self into as few parts as possible with none exceeding cap in absolute value,
+// e.g. for payout rails limiting the amount per transfer. The amount is divided evenly like Split does,
+// so 250.00 with a cap of 100.00 gives three parts of 83.34, 83.33 and 83.33. Amounts needing more than
+// maxParts parts fail before any part is allocated.
+func (m *Money) SplitWithCap(cap *Money, maxParts int) ([]*Money, error) {
+	if err := m.assertSameCurrency(cap); err != nil {
+		return nil, err
 	}
-	for p := 0; l != 0; p++ {
-		ms[p].amount = mutate.calc.add(ms[p].amount, v)
-		l--
+
+	if !cap.IsPositive() {
+		return nil, errors.New("cap must be higher than zero")
+	}
+
+	if maxParts <= 0 {
+		return nil, errors.New("max parts must be higher than zero")
+	}
+
+	a, c := magnitude(m.amount), uint64(cap.amount)
+	n := a / c
+	if a%c != 0 || n == 0 {
+		n++
+	}
+
+	if n > uint64(maxParts) {
+		return nil, fmt.Errorf("amount needs %d parts, more than %d", n, maxParts)
+	}
+
+	return m.Split(int(n))
+}
+
+// SplitWithMinimum works like Split, failing with ErrBelowMinimum if any party would receive less than min.
+func (m *Money) SplitWithMinimum(n int, min *Money) ([]*Money, error) {
+	if err := m.assertSameCurrency(min); err != nil {
+		return nil, err
+	}
+
+	ms, err := m.Split(n)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remainders go to the first parties, which makes their parts the smallest ones for negative amounts.
+	for _, p := range ms {
+		if p.compare(min) == -1 {
+			return nil, ErrBelowMinimum
+		}
 	}
 
 	return ms, nil
 }
 
+// SplitUpToMinimum works like Split, reducing the number of parties if needed so that every party receives
+// at least min. ErrBelowMinimum is returned only if Self is less than min.
+func (m *Money) SplitUpToMinimum(n int, min *Money) ([]*Money, error) {
+	if err := m.assertSameCurrency(min); err != nil {
+		return nil, err
+	}
+
+	if n <= 0 {
+		return nil, errors.New("split must be higher than zero")
+	}
+
+	if m.compare(min) == -1 {
+		return nil, ErrBelowMinimum
+	}
+
+	if min.IsPositive() {
+		if k := mutate.calc.divide(m.amount, min.amount); k < int64(n) {
+			n = int(k)
+		}
+	}
+
+	return m.Split(n)
+}
+
+// parts returns Money structs of the given amounts in the currency of Self.
+func (m *Money) parts(as []Amount) []*Money {
+	ms := make([]*Money, len(as))
+	for i, a := range as {
+		ms[i] = &Money{amount: a, currency: m.currency}
+	}
+
+	return ms
+}
+
+// RemainderStrategy decides which parties receive the leftover pennies
+// when Money can't be divided evenly.
+type RemainderStrategy int
+
+const (
+	// RemainderFirst adds the whole remainder to the first party.
+	RemainderFirst RemainderStrategy = iota
+	// RemainderLast adds the whole remainder to the last party.
+	RemainderLast
+	// RemainderRoundRobin distributes the remainder one penny at a time starting from the first party, like Split does.
+	RemainderRoundRobin
+	// RemainderReverseRoundRobin distributes the remainder one penny at a time starting from the last party.
+	RemainderReverseRoundRobin
+)
+
 // Allocate returns slice of Money structs with split Self value in given ratios.
 // It lets split money by given ratios without losing pennies and as Split operations distributes
 // leftover pennies amongst the parties with round-robin principle.
 func (m *Money) Allocate(rs ...int) ([]*Money, error) {
+	r, err := m.AllocateDetailed(rs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Shares, nil
+}
+
+// AllocationResult describes how Money was allocated, so every penny of a split can be accounted for.
+type AllocationResult struct {
+	// Shares holds the final amount of every party.
+	Shares []*Money
+	// Leftover is the amount which couldn't be allocated proportionally and was distributed afterwards.
+	Leftover *Money
+	// Extra lists the parties, by index, which received one extra smallest unit of the leftover.
+	Extra []int
+}
+
+// AllocateDetailed works like Allocate, additionally returning the leftover distributed and the parties which received it.
+func (m *Money) AllocateDetailed(rs ...int) (*AllocationResult, error) {
 	if len(rs) == 0 {
 		return nil, errors.New("no ratios specified")
 	}
 
-	// Calculate sum of ratios.
-	var sum uint
+	// Calculate sum of ratios, on 128 bits so that large ratios like amounts can't overflow it.
+	var sum Int128
 	for _, r := range rs {
 		if r < 0 {
 			return nil, errors.New("negative ratios not allowed")
 		}
-		sum += uint(r)
+		sum = sum.Add(NewInt128(int64(r)))
 	}
 
 	var total int64
-	ms := make([]*Money, 0, len(rs))
-	for _, r := range rs {
-		party := &Money{
-			amount:   mutate.calc.allocate(m.amount, uint(r), sum),
-			currency: m.currency,
-		}
-
-		ms = append(ms, party)
-		total += party.amount
+	as := make([]Amount, len(rs))
+	for i, r := range rs {
+		as[i] = mutate.calc.allocate(m.amount, int64(r), sum)
+		total += as[i]
 	}
 
+	res := &AllocationResult{Leftover: &Money{currency: m.currency}}
+
 	// if the sum of all ratios is zero, then we just returns zeros and don't do anything
 	// with the leftover
-	if sum == 0 {
-		return ms, nil
+	if sum.Sign() != 0 {
+		// Calculate leftover value and divide to first parties.
+		lo := m.amount - total
+		res.Leftover.amount = lo
+		for p, extra := 0, mutate.calc.spread(as, lo); p < extra; p++ {
+			res.Extra = append(res.Extra, p)
+		}
 	}
 
-	// Calculate leftover value and divide to first parties.
-	lo := m.amount - total
-	sub := int64(1)
-	if lo < 0 {
-		sub = -sub
+	res.Shares = m.parts(as)
+	return res, nil
+}
+
+// AllocateWithMinimum works like Allocate, failing with ErrBelowMinimum if any party with a ratio higher
+// than zero would receive less than min.
+func (m *Money) AllocateWithMinimum(min *Money, rs ...int) ([]*Money, error) {
+	if err := m.assertSameCurrency(min); err != nil {
+		return nil, err
 	}
 
-	for p := 0; lo != 0; p++ {
-		ms[p].amount = mutate.calc.add(ms[p].amount, sub)
-		lo -= sub
+	ms, err := m.Allocate(rs...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, p := range ms {
+		if rs[i] > 0 && p.compare(min) == -1 {
+			return nil, ErrBelowMinimum
+		}
 	}
 
 	return ms, nil
 }
 
+// AllocateBy returns slice of Money structs with split Self value proportionally to the given weights,
+// e.g. to distribute a rebate proportionally to each customer's spend. All weights must share the same
+// currency, which may differ from the one of Self, and must not be negative.
+func (m *Money) AllocateBy(weights ...*Money) ([]*Money, error) {
+	rs := make([]int, len(weights))
+	for i, w := range weights {
+		if err := weights[0].assertSameCurrency(w); err != nil {
+			return nil, err
+		}
+
+		if w.IsNegative() {
+			return nil, errors.New("negative weights not allowed")
+		}
+		rs[i] = int(w.amount)
+	}
+
+	return m.Allocate(rs...)
+}
+
+// AllocateFixed returns slice of Money structs where the first parties receive the given fixed amounts
+// and the remainder is allocated amongst the other parties by ratios, like Allocate does.
+// For example a marketplace payout of a fixed fee plus a revenue share between seller and platform:
+//
+//	parts, err := payment.AllocateFixed([]*money.Money{fee}, 90, 10)
+//	// parts[0] is fee, parts[1] and parts[2] share payment minus fee 90:10
+//
+// Fixed amounts must share the currency of Self and must not exceed it.
+func (m *Money) AllocateFixed(fixed []*Money, rs ...int) ([]*Money, error) {
+	rest := m.amount
+	ms := make([]*Money, 0, len(fixed)+len(rs))
+	for _, f := range fixed {
+		if err := m.assertSameCurrency(f); err != nil {
+			return nil, err
+		}
+
+		rest = mutate.calc.subtract(rest, f.amount)
+		ms = append(ms, &Money{amount: f.amount, currency: m.currency})
+	}
+
+	if rest != 0 && (rest < 0) != (m.amount < 0) {
+		return nil, errors.New("fixed amounts exceed total")
+	}
+
+	shares, err := (&Money{amount: rest, currency: m.currency}).Allocate(rs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(ms, shares...), nil
+}
+
+// AllocateFloat works like Allocate with ratios containing decimals, e.g. 33.33 and 66.67.
+// Ratios are scaled internally to integers using as many decimal places as the most precise ratio has.
+func (m *Money) AllocateFloat(rs ...float64) ([]*Money, error) {
+	ds := make([]string, len(rs))
+	for i, r := range rs {
+		if math.IsNaN(r) || math.IsInf(r, 0) {
+			return nil, errors.New("ratios must be finite numbers")
+		}
+		ds[i] = strconv.FormatFloat(r, 'f', -1, 64)
+	}
+
+	return m.AllocateDecimal(ds...)
+}
+
+// AllocateDecimal works like Allocate with ratios given as decimal strings, e.g. "33.33" and "66.67",
+// avoiding any floating point imprecision.
+func (m *Money) AllocateDecimal(rs ...string) ([]*Money, error) {
+	var places int
+	for _, r := range rs {
+		if i := strings.Index(r, "."); i != -1 && len(r)-i-1 > places {
+			places = len(r) - i - 1
+		}
+	}
+
+	ints := make([]int, len(rs))
+	for i, r := range rs {
+		digits := r
+		decimals := 0
+		if p := strings.Index(r, "."); p != -1 {
+			digits = r[:p] + r[p+1:]
+			decimals = len(r) - p - 1
+		}
+		digits += strings.Repeat("0", places-decimals)
+
+		v, err := strconv.ParseInt(digits, 10, strconv.IntSize)
+		if err != nil || strings.HasPrefix(digits, "+") {
+			return nil, fmt.Errorf("invalid ratio '%s'", r)
+		}
+		ints[i] = int(v)
+	}
+
+	return m.Allocate(ints...)
+}
+
 // Display lets represent Money struct as string in given Currency value.
 func (m *Money) Display() string {
-	c := m.currency.get()
-	return c.Formatter().Format(m.amount)
+	f := m.currency.get().formatter()
+	return f.Format(m.amount)
+}
+
+// MajorPart returns the whole major units of Money truncated towards zero, e.g. 12 for €12.34 and -12 for -€12.34.
+func (m *Money) MajorPart() int64 {
+	return mutate.calc.divide(m.amount, m.unit())
+}
+
+// MinorPart returns the minor units left over after MajorPart, with the same sign as Money,
+// e.g. 34 for €12.34 and -34 for -€12.34. It's always 0 for currencies without a fraction.
+// Amounts between -1 and 0 major units have a MajorPart of 0, so use IsNegative to render their sign.
+func (m *Money) MinorPart() int64 {
+	return mutate.calc.modulus(m.amount, m.unit())
+}
+
+// unit returns the number of minor units in a major unit.
+func (m *Money) unit() int64 {
+	return int64(math.Pow10(m.currency.get().Fraction))
 }
 
 // AsMajorUnits lets represent Money struct as subunits (float64) in given Currency value
@@ -387,7 +964,8 @@ func (m *Money) AsMajorUnits() float64 {
 	return c.Formatter().ToMajorUnits(m.amount)
 }
 
-// UnmarshalJSON is implementation of json.Unmarshaller
+// UnmarshalJSON is implementation of json.Unmarshaller.
+// The default implementation accepts currency codes in lower case, with surrounding whitespace or trailing nulls.
 func (m *Money) UnmarshalJSON(b []byte) error {
 	return UnmarshalJSON(m, b)
 }
@@ -0,0 +1,140 @@
+package money
+
+import (
+	"fmt"
+)
+
+// Money128 represents monetary value information like Money, using an Int128 amount.
+// Use it for currencies with many decimal places or aggregates which may overflow Money.
+type Money128 struct {
+	amount   Int128
+	currency *Currency
+}
+
+// New128 creates and returns new instance of Money128.
+func New128(amount Int128, currencyCode string) (*Money128, error) {
+	currency := GetCurrency(currencyCode)
+	if currency == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", currencyCode)
+	}
+
+	return &Money128{amount: amount, currency: currency}, nil
+}
+
+// To128 returns Money as Money128.
+func (m *Money) To128() *Money128 {
+	return &Money128{amount: NewInt128(m.amount), currency: m.currency}
+}
+
+// ToMoney returns Money128 as Money, failing if the amount doesn't fit into an int64.
+func (m *Money128) ToMoney() (*Money, error) {
+	a, ok := m.amount.Int64()
+	if !ok {
+		return nil, fmt.Errorf("amount %s overflows int64", m.amount)
+	}
+
+	return &Money{amount: a, currency: m.currency}, nil
+}
+
+// CurrencyCode returns the currency code used by Money128.
+func (m *Money128) CurrencyCode() string {
+	if m.currency == nil {
+		return ""
+	}
+
+	return m.currency.Code
+}
+
+// AmountUnformatted returns a copy of the internal monetary value as an Int128.
+func (m *Money128) AmountUnformatted() Int128 {
+	return m.amount
+}
+
+// Amount returns the formatted amount without the currency template, using the separators of the currency
+// like Money.Amount does.
+func (m *Money128) Amount() string {
+	c := m.currency.get()
+	f := Formatter{Fraction: c.Fraction, Decimal: c.Decimal, Thousand: c.Thousand}
+	return f.format([]byte(m.amount.digits()), m.amount.Sign() < 0, false)
+}
+
+// Display lets represent Money128 struct as string in given Currency value.
+func (m *Money128) Display() string {
+	return m.formatter().format([]byte(m.amount.digits()), m.amount.Sign() < 0, true)
+}
+
+func (m *Money128) formatter() *Formatter {
+	return m.currency.get().Formatter()
+}
+
+// SameCurrency check if given Money128 is equals by currency.
+func (m *Money128) SameCurrency(om *Money128) bool {
+	return m.currency.equals(om.currency)
+}
+
+// Compare compares two Money128 of the same currency, returning -1, 0 or 1.
+func (m *Money128) Compare(om *Money128) (int, error) {
+	if !m.SameCurrency(om) {
+		return 0, ErrCurrencyMismatch
+	}
+
+	return m.amount.Cmp(om.amount), nil
+}
+
+// IsZero returns boolean of whether the value of Money128 is equals to zero.
+func (m *Money128) IsZero() bool {
+	return m.amount.Sign() == 0
+}
+
+// IsPositive returns boolean of whether the value of Money128 is positive.
+func (m *Money128) IsPositive() bool {
+	return m.amount.Sign() > 0
+}
+
+// IsNegative returns boolean of whether the value of Money128 is negative.
+func (m *Money128) IsNegative() bool {
+	return m.amount.Sign() < 0
+}
+
+// Add returns new Money128 struct with value representing sum of Self and Other Money128.
+func (m *Money128) Add(om *Money128) (*Money128, error) {
+	if !m.SameCurrency(om) {
+		return nil, ErrCurrencyMismatch
+	}
+
+	return &Money128{amount: m.amount.Add(om.amount), currency: m.currency}, nil
+}
+
+// AddMoney returns new Money128 struct with value representing sum of Self and Money,
+// which is handy to aggregate many Money without overflowing.
+func (m *Money128) AddMoney(om *Money) (*Money128, error) {
+	return m.Add(om.To128())
+}
+
+// Subtract returns new Money128 struct with value representing difference of Self and Other Money128.
+func (m *Money128) Subtract(om *Money128) (*Money128, error) {
+	if !m.SameCurrency(om) {
+		return nil, ErrCurrencyMismatch
+	}
+
+	return &Money128{amount: m.amount.Sub(om.amount), currency: m.currency}, nil
+}
+
+// Multiply returns new Money128 struct with value representing Self multiplied value by multiplier.
+func (m *Money128) Multiply(mul int64) *Money128 {
+	return &Money128{amount: m.amount.Mul64(mul), currency: m.currency}
+}
+
+// Absolute returns new Money128 struct from given Money128 using absolute monetary value.
+func (m *Money128) Absolute() *Money128 {
+	return &Money128{amount: m.amount.Abs(), currency: m.currency}
+}
+
+// Negative returns new Money128 struct from given Money128 using negative monetary value.
+func (m *Money128) Negative() *Money128 {
+	if m.amount.Sign() > 0 {
+		return &Money128{amount: m.amount.Neg(), currency: m.currency}
+	}
+
+	return &Money128{amount: m.amount, currency: m.currency}
+}
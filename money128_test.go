@@ -0,0 +1,61 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMoney128_Aggregate(t *testing.T) {
+	AddCurrency("WEI18", "Ξ", "$1", ".", ",", 18)
+
+	one, _ := New(1000000000000000000, "WEI18")
+	sum, _ := New128(NewInt128(0), "WEI18")
+	for i := 0; i < 20; i++ {
+		sum, _ = sum.AddMoney(one)
+	}
+
+	if sum.Display() != "Ξ20.000000000000000000" {
+		t.Errorf("Expected %s got %s", "Ξ20.000000000000000000", sum.Display())
+	}
+
+	if sum.Negative().Amount() != "-20.000000000000000000" {
+		t.Errorf("Expected %s got %s", "-20.000000000000000000", sum.Negative().Amount())
+	}
+
+	if _, err := sum.ToMoney(); err == nil {
+		t.Error("Expected int64 overflow")
+	}
+
+	back, _ := sum.Subtract(one.To128().Multiply(19))
+	m, err := back.ToMoney()
+	if err != nil || m.amount != one.amount {
+		t.Errorf("Expected %d got %v (%v)", one.amount, m, err)
+	}
+}
+
+func TestMoney128_Compare(t *testing.T) {
+	a, _ := New128(NewInt128(math.MaxInt64).Mul64(2), EUR)
+	b, _ := New128(NewInt128(math.MaxInt64), EUR)
+	c, _ := New128(NewInt128(1), USD)
+
+	if r, err := a.Compare(b); err != nil || r != 1 {
+		t.Errorf("Expected 1 got %d (%v)", r, err)
+	}
+
+	if _, err := a.Compare(c); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := a.Add(c); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if !a.IsPositive() || a.Negative().IsPositive() || !a.Negative().Absolute().IsPositive() || a.IsZero() {
+		t.Error("Unexpected sign")
+	}
+
+	if _, err := New128(NewInt128(1), "UNKNOWN"); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+}
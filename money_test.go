@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"testing"
 )
@@ -360,6 +361,99 @@ func TestMoney_Subtract2(t *testing.T) {
 	}
 }
 
+func TestMoney_AddAll(t *testing.T) {
+	net, _ := New(1000, EUR)
+	tax, _ := New(210, EUR)
+	shipping, _ := New(495, EUR)
+
+	r, err := net.AddAll(tax, shipping)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.amount != 1705 {
+		t.Errorf("Expected %d got %d", 1705, r.amount)
+	}
+
+	r, err = net.AddAll()
+	if err != nil || r.amount != 1000 {
+		t.Errorf("Expected %d got %d", 1000, r.amount)
+	}
+
+	r, err = net.SubtractAll(tax, shipping)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.amount != 295 {
+		t.Errorf("Expected %d got %d", 295, r.amount)
+	}
+}
+
+func TestMoney_AddAll_Errors(t *testing.T) {
+	eur, _ := New(1, EUR)
+	gbp, _ := New(1, GBP)
+	max, _ := New(math.MaxInt64, EUR)
+	min, _ := New(math.MinInt64, EUR)
+
+	if _, err := eur.AddAll(eur, gbp); err != ErrCurrencyMismatch {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := eur.SubtractAll(gbp); err != ErrCurrencyMismatch {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := max.AddAll(eur); err != ErrOverflow {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if _, err := min.SubtractAll(eur); err != ErrOverflow {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	// Intermediate results may not overflow either.
+	if _, err := max.AddAll(eur, eur.Negative()); err != ErrOverflow {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func TestMoney_AddAssign(t *testing.T) {
+	row, _ := New(250, EUR)
+	total, _ := New(0, EUR)
+	for i := 0; i < 4; i++ {
+		if err := total.AddAssign(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if total.amount != 1000 {
+		t.Errorf("Expected %d got %d", 1000, total.amount)
+	}
+
+	if err := total.SubtractAssign(row); err != nil {
+		t.Fatal(err)
+	}
+
+	total.MultiplyAssign(2)
+	if total.amount != 1500 {
+		t.Errorf("Expected %d got %d", 1500, total.amount)
+	}
+
+	if row.amount != 250 {
+		t.Errorf("Expected operand to stay %d got %d", 250, row.amount)
+	}
+
+	gbp, _ := New(1, GBP)
+	if err := total.AddAssign(gbp); err != ErrCurrencyMismatch || total.amount != 1500 {
+		t.Errorf("Expected %v leaving %d got %v leaving %d", ErrCurrencyMismatch, 1500, err, total.amount)
+	}
+
+	if err := total.SubtractAssign(gbp); err != ErrCurrencyMismatch || total.amount != 1500 {
+		t.Errorf("Expected %v leaving %d got %v leaving %d", ErrCurrencyMismatch, 1500, err, total.amount)
+	}
+}
+
 func TestMoney_Multiply(t *testing.T) {
 	tcs := []struct {
 		amount     int64
@@ -563,6 +657,29 @@ func TestMoney_AsMajorUnits(t *testing.T) {
 	}
 }
 
+func TestMoney_MajorMinorPart(t *testing.T) {
+	tcs := []struct {
+		amount       int64
+		code         string
+		major, minor int64
+	}{
+		{1234, EUR, 12, 34},
+		{-1234, EUR, -12, -34},
+		{5, EUR, 0, 5},
+		{-5, EUR, 0, -5},
+		{1000, JPY, 1000, 0},
+		{-12345, BHD, -12, -345},
+		{math.MinInt64, EUR, math.MinInt64 / 100, -8},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		if m.MajorPart() != tc.major || m.MinorPart() != tc.minor {
+			t.Errorf("Expected %d %s to split into %d and %d got %d and %d", tc.amount, tc.code, tc.major, tc.minor, m.MajorPart(), m.MinorPart())
+		}
+	}
+}
+
 func TestMoney_Allocate3(t *testing.T) {
 	pound, _ := New(100, GBP)
 	parties, err := pound.Allocate(33, 33, 33)
@@ -584,6 +701,55 @@ func TestMoney_Allocate3(t *testing.T) {
 	}
 }
 
+func TestMoney_Allocate4(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		ratios   []int
+		expected []int64
+	}{
+		{math.MaxInt64, []int{1, 1}, []int64{4611686018427387904, 4611686018427387903}},
+		{math.MaxInt64, []int{2, 3, 5}, []int64{1844674407370955162, 2767011611056432742, 4611686018427387903}},
+		{math.MinInt64, []int{3, 3}, []int64{-4611686018427387904, -4611686018427387904}},
+		{-math.MaxInt64, []int{1, 2}, []int64{-3074457345618258603, -6148914691236517204}},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		parties, err := m.Allocate(tc.ratios...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, p := range parties {
+			if p.amount != tc.expected[i] {
+				t.Errorf("Expected party %d of %d to get %d got %d", i, tc.amount, tc.expected[i], p.amount)
+			}
+		}
+	}
+}
+
+func TestMoney_Allocate5(t *testing.T) {
+	rs := make([]int, 5000)
+	for i := range rs {
+		rs[i] = i%7 + 1
+	}
+
+	m, _ := New(math.MaxInt64-1, EUR)
+	r, err := m.AllocateDetailed(rs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sum Amount
+	for _, p := range r.Shares {
+		sum += p.amount
+	}
+
+	if sum != m.amount || len(r.Extra) != int(r.Leftover.amount) || r.Leftover.amount >= int64(len(rs)) {
+		t.Errorf("Expected shares to add up to %d got %d with leftover %d", m.amount, sum, r.Leftover.amount)
+	}
+}
+
 func TestMoney_Comparison(t *testing.T) {
 	pound, _ := New(100, GBP)
 	twoPounds, _ := New(200, GBP)
@@ -657,6 +823,74 @@ func TestMoney_Amount(t *testing.T) {
 	}
 }
 
+func TestMoney_AmountFixed(t *testing.T) {
+	AddCurrency("XFX", "F", "1 $", ",", ".", 2)
+
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+	}{
+		{123456789, "XFX", "1234567.89"},
+		{-123456789, "XFX", "-1234567.89"},
+		{1000, JPY, "1000"},
+		{5, BHD, "0.005"},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		if r := m.AmountFixed(); r != tc.expected {
+			t.Errorf("Expected %d %s to be %s got %s", tc.amount, tc.code, tc.expected, r)
+		}
+	}
+
+	m, _ := New(123456789, "XFX")
+	if m.Amount() != "1.234.567,89" {
+		t.Errorf("Expected %s got %s", "1.234.567,89", m.Amount())
+	}
+}
+
+func TestMoney_Amount2(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+	}{
+		{1234, EUR, "12.34"},
+		{-1234, EUR, "-12.34"},
+		{5, EUR, "0.05"},
+		{-5, EUR, "-0.05"},
+		{0, EUR, "0.00"},
+		{1234567, JPY, "1234567"},
+		{-1, JPY, "-1"},
+		{12345, IQD, "12.345"},
+		{math.MaxInt64, EUR, "92233720368547758.07"},
+		{math.MinInt64, EUR, "-92233720368547758.08"},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		if r := m.Amount(); r != tc.expected {
+			t.Errorf("Expected %d %s to be %s got %s", tc.amount, tc.code, tc.expected, r)
+		}
+
+		if f := m.currency.Formatter().FormatAmount(tc.amount); tc.amount != math.MinInt64 && f != m.Amount() {
+			t.Errorf("Expected %s to match formatter output %s", m.Amount(), f)
+		}
+	}
+
+	micros, _ := NewFromMicros(-1, USD)
+	if micros.Amount() != "-0.000001" {
+		t.Errorf("Expected %s got %s", "-0.000001", micros.Amount())
+	}
+
+	AddCurrency("XDC", "D", "1 $", ",", ".", 2)
+	m, _ := New(123456, "XDC")
+	if m.Amount() != "1.234,56" {
+		t.Errorf("Expected %s got %s", "1.234,56", m.Amount())
+	}
+}
+
 func TestNewFromFloat(t *testing.T) {
 	m, _ := NewFromFloat(12.34, EUR)
 
@@ -757,6 +991,34 @@ func TestCustomMarshal(t *testing.T) {
 	}
 }
 
+func TestJSON_RegisteredFormatter(t *testing.T) {
+	defer RegisterFormatter(EUR, nil)
+	defer defaultCodecs()()
+	RegisterFormatter(EUR, NewFormatter(2, ",", ".", "€", "1 $"))
+
+	given, _ := New(123456, EUR)
+	expected := `{"amount":"1234.56","currency":"EUR"}`
+
+	b, err := json.Marshal(given)
+	if err != nil || string(b) != expected {
+		t.Errorf("Expected %s got %s, %v", expected, b, err)
+	}
+
+	var m Money
+	if err := json.Unmarshal(b, &m); err != nil || m != *given {
+		t.Errorf("Expected %v got %v, %v", given, m, err)
+	}
+
+	csv, _ := given.MarshalCSV()
+	if csv != "EUR 1234.56" {
+		t.Errorf("Expected %s got %s", "EUR 1234.56", csv)
+	}
+
+	if given.Display() != "1.234,56 €" {
+		t.Errorf("Expected %s got %s", "1.234,56 €", given.Display())
+	}
+}
+
 func TestDefaultUnmarshal(t *testing.T) {
 	given := `{"amount": "100.12", "currency":"USD"}`
 	expected := "$100.12"
@@ -794,6 +1056,55 @@ func TestDefaultUnmarshal(t *testing.T) {
 	}
 }
 
+func TestDefaultUnmarshal2(t *testing.T) {
+	var ms []Money
+	err := json.Unmarshal([]byte(`[{"amount": "1.5", "currency": "EUR"}, {"currency": "\u0055SD", "amount": "-2", "extra": [1, {}]}, {}]`), &ms)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ms) != 3 || ms[0].Display() != "€1.50" || ms[1].Display() != "-$2.00" || ms[2] != (Money{}) {
+		t.Errorf("Expected %s, %s and zero value got %+v", "€1.50", "-$2.00", ms)
+	}
+
+	for _, given := range []string{`{"amount": null, "currency": "USD"}`, `{"amount": "1", "currency": ["USD"]}`} {
+		var m Money
+		if err := json.Unmarshal([]byte(given), &m); !errors.Is(err, ErrInvalidJSON) {
+			t.Errorf("Expected ErrInvalidJSON for %s, got %+v", given, err)
+		}
+	}
+
+	var m Money
+	if err := json.Unmarshal([]byte(`{"amount": "1"`), &m); err == nil {
+		t.Error("Expected error for truncated json")
+	}
+}
+
+func TestDefaultUnmarshal_LenientCurrency(t *testing.T) {
+	tcs := []string{
+		`{"amount": "1.50", "currency": "eur"}`,
+		`{"amount": "1.50", "currency": " Eur\t"}`,
+		`{"amount": "1.50", "currency": "EUR\u0000\u0000"}`,
+		`{"amount": "1.50", "currency": "eur \u0000"}`,
+	}
+
+	for _, given := range tcs {
+		var m Money
+		if err := json.Unmarshal([]byte(given), &m); err != nil || m.Display() != "€1.50" || m.CurrencyCode() != EUR {
+			t.Errorf("Expected €1.50 for %s got %s, %v", given, m.Display(), err)
+		}
+	}
+
+	var m Money
+	if err := json.Unmarshal([]byte(`{"amount": "", "currency": " \u0000"}`), &m); err != nil || m != (Money{}) {
+		t.Errorf("Expected zero value got %+v, %v", m, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"amount": "1", "currency": "E UR"}`), &m); err == nil {
+		t.Error("Expected error for currency with inner whitespace")
+	}
+}
+
 func TestCustomUnmarshal(t *testing.T) {
 	given := `{"amount": 10012, "currency_code":"USD", "currency_fraction":2}`
 	expected := "$100.12"
@@ -818,3 +1129,854 @@ func TestCustomUnmarshal(t *testing.T) {
 		t.Errorf("Expected %s got %s", expected, m.Display())
 	}
 }
+
+func TestMoney_Percent(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		rate     Rate
+		expected int64
+	}{
+		{100, Rate{15, 100}, 15},
+		{1999, Rate{15, 100}, 300},
+		{-1999, Rate{15, 100}, -300},
+		{1999, Rate{}, 0},
+		{1000, Rate{8875, 100000}, 89},
+		// The product of amount and rate exceeds an int64.
+		{200000000000000000, Rate{8875, 100000}, 17750000000000000},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		r, err := m.Percent(tc.rate)
+
+		if err != nil || r.amount != tc.expected {
+			t.Errorf("Expected %v of %d to be %d got %v (%v)", tc.rate, tc.amount, tc.expected, r, err)
+		}
+	}
+
+	m, _ := New(math.MaxInt64, EUR)
+	if _, err := m.Percent(Rate{150, 100}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func TestMoney_CashRound(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected int64
+	}{
+		{1002, CHF, 1000},
+		{1003, CHF, 1005},
+		{1007, CHF, 1005},
+		{-1003, CHF, -1005},
+		{1049, SEK, 1000},
+		{1050, SEK, 1100},
+		{-1050, SEK, -1100},
+		{1001, CAD, 1000},
+		{1025, DKK, 1050},
+		{1003, EUR, 1003},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		r, err := m.CashRound()
+
+		if err != nil || r.amount != tc.expected {
+			t.Errorf("Expected %d %s to be cash rounded to %d got %v (%v)", tc.amount, tc.code, tc.expected, r, err)
+		}
+	}
+
+	// Rounds to -9223372036854775810.
+	m, _ := New(math.MinInt64, CHF)
+	if _, err := m.CashRound(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func TestMoney_Rescale(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		fraction int
+		mode     RoundingMode
+		expected int64
+		display  string
+	}{
+		{123, EUR, 4, RoundHalfUp, 12300, "€1.2300"},
+		{12345, EUR, 2, RoundHalfUp, 12345, "€123.45"},
+		{12345, EUR, 1, RoundHalfUp, 1235, "€123.5"},
+		{12345, EUR, 1, RoundHalfEven, 1234, "€123.4"},
+		{-12345, EUR, 0, RoundFloor, -124, "-€124"},
+		{1234, JPY, 2, RoundDown, 123400, "¥1234.00"},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		r, err := m.Rescale(tc.fraction, tc.mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.amount != tc.expected || r.Display() != tc.display {
+			t.Errorf("Expected %d rescaled to %d decimals to be %d (%s) got %d (%s)", tc.amount, tc.fraction, tc.expected, tc.display, r.amount, r.Display())
+		}
+	}
+}
+
+func TestMoney_Rescale2(t *testing.T) {
+	m, _ := New(12345, EUR)
+	r, _ := m.Rescale(4, RoundHalfUp)
+
+	if r.SameCurrency(m) {
+		t.Error("Expected rescaled Money not to share currency with the original")
+	}
+
+	if _, err := r.Add(m); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	b, _ := r.Rescale(2, RoundHalfUp)
+	if ok, err := b.Equals(m); err != nil || !ok {
+		t.Errorf("Expected rescaling back to give %d got %d (%v)", m.amount, b.amount, err)
+	}
+
+	if b.currency != GetCurrency(EUR) {
+		t.Error("Expected rescaling back to use the listed currency")
+	}
+
+	if _, err := m.Rescale(-1, RoundHalfUp); err == nil {
+		t.Error("Expected error for negative fraction")
+	}
+
+	if _, err := m.Rescale(4, RoundingMode(42)); err == nil {
+		t.Error("Expected error for unknown rounding mode")
+	}
+
+	if _, err := m.Rescale(20, RoundHalfUp); err == nil {
+		t.Error("Expected error for overflow")
+	}
+}
+
+func TestMoney_DivMod(t *testing.T) {
+	tcs := []struct {
+		amount    int64
+		divisor   int64
+		quotient  int64
+		remainder int64
+	}{
+		{100, 3, 33, 1},
+		{100, -3, -33, 1},
+		{-100, 3, -33, -1},
+		{99, 3, 33, 0},
+		{2, 3, 0, 2},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		q, r, err := m.DivMod(tc.divisor)
+
+		if err != nil || q.amount != tc.quotient || r.amount != tc.remainder {
+			t.Errorf("Expected %d divmod %d to be %d, %d got %d, %d", tc.amount, tc.divisor, tc.quotient, tc.remainder, q.amount, r.amount)
+		}
+	}
+
+	m, _ := New(100, EUR)
+	if _, _, err := m.DivMod(0); err == nil {
+		t.Error("Expected error for division by zero")
+	}
+}
+
+func TestMoney_Clamp(t *testing.T) {
+	min, _ := New(100, EUR)
+	max, _ := New(500, EUR)
+	tcs := []struct {
+		amount   int64
+		expected int64
+	}{
+		{-100, 100},
+		{100, 100},
+		{300, 300},
+		{500, 500},
+		{501, 500},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		r, err := m.Clamp(min, max)
+
+		if err != nil || r.amount != tc.expected {
+			t.Errorf("Expected %d clamped to [%d, %d] to be %d got %d", tc.amount, min.amount, max.amount, tc.expected, r.amount)
+		}
+	}
+}
+
+func TestMoney_Clamp2(t *testing.T) {
+	m, _ := New(300, EUR)
+	min, _ := New(100, EUR)
+	max, _ := New(500, EUR)
+	usd, _ := New(500, USD)
+
+	if _, err := m.Clamp(usd, max); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := m.Clamp(min, usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := m.Clamp(max, min); err == nil {
+		t.Error("Expected error for min greater than max")
+	}
+}
+
+func TestMoney_Between(t *testing.T) {
+	min, _ := New(100, EUR)
+	max, _ := New(500, EUR)
+	tcs := []struct {
+		amount    int64
+		inclusive bool
+		expected  bool
+	}{
+		{99, true, false},
+		{100, true, true},
+		{300, true, true},
+		{500, true, true},
+		{501, true, false},
+		{100, false, false},
+		{101, false, true},
+		{499, false, true},
+		{500, false, false},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		r, err := m.Between(min, max, tc.inclusive)
+
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected %d Between %d and %d (inclusive %t) == %t got %t", tc.amount, min.amount, max.amount, tc.inclusive, tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_Between2(t *testing.T) {
+	m, _ := New(300, EUR)
+	min, _ := New(100, EUR)
+	max, _ := New(500, EUR)
+	usd, _ := New(500, USD)
+
+	if _, err := m.Between(min, usd, true); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := m.Between(max, min, true); err == nil {
+		t.Error("Expected error for min greater than max")
+	}
+}
+
+func TestMoney_EqualsWithin(t *testing.T) {
+	m, _ := New(1000, EUR)
+	tolerance, _ := New(2, EUR)
+	tcs := []struct {
+		amount   int64
+		expected bool
+	}{
+		{997, false},
+		{998, true},
+		{1000, true},
+		{1002, true},
+		{1003, false},
+	}
+
+	for _, tc := range tcs {
+		om, _ := New(tc.amount, EUR)
+		r, err := m.EqualsWithin(om, tolerance)
+
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected %d Equals %d within %d == %t got %t", m.amount, om.amount, tolerance.amount, tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_EqualsWithin2(t *testing.T) {
+	m, _ := New(1000, EUR)
+	usd, _ := New(1, USD)
+	negative, _ := New(-1, EUR)
+
+	if _, err := m.EqualsWithin(m, usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := m.EqualsWithin(usd, negative); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := m.EqualsWithin(m, negative); err == nil {
+		t.Error("Expected error for negative tolerance")
+	}
+}
+
+func TestMoney_Comparable(t *testing.T) {
+	a, _ := New(100, EUR)
+	b, _ := NewFromString("1.00", EUR)
+	c, _ := New(100, USD)
+
+	if *a != *b {
+		t.Errorf("Expected %v to == %v", a, b)
+	}
+
+	if *a == *c {
+		t.Errorf("Expected %v to != %v", a, c)
+	}
+
+	r, _ := a.Rescale(4, RoundHalfUp)
+	if *r == *a {
+		t.Errorf("Expected rescaled %v to != %v", r, a)
+	}
+
+	if r2, _ := b.Rescale(4, RoundHalfUp); *r2 != *r {
+		t.Errorf("Expected rescaled %v to == %v", r2, r)
+	}
+
+	totals := map[Money]int{}
+	totals[*a]++
+	totals[*b]++
+	totals[*c]++
+
+	if totals[*a] != 2 || totals[*c] != 1 {
+		t.Errorf("Expected Money to be usable as map key got %v", totals)
+	}
+
+	var zero Money
+	if !zero.IsZero() || zero.CurrencyCode() != "" {
+		t.Errorf("Expected zero Money got %v", zero)
+	}
+
+	if zero.Display() != "0.00" || !zero.SameCurrency(&Money{}) || zero.SameCurrency(a) {
+		t.Errorf("Expected zero Money to display %s got %s", "0.00", zero.Display())
+	}
+}
+
+func TestMoney_Derivation(t *testing.T) {
+	m, _ := New(100, EUR)
+
+	c := m.Clone()
+	if c == m || *c != *m {
+		t.Errorf("Expected clone of %v to be a distinct equal value got %v", m, c)
+	}
+
+	a := m.WithAmount(250)
+	if a.amount != 250 || a.currency != m.currency || m.amount != 100 {
+		t.Errorf("Expected %d %s got %d %s", 250, EUR, a.amount, a.currency.Code)
+	}
+
+	u, err := m.WithCurrency(USD)
+	if err != nil || u.amount != 100 || u.currency.Code != USD || m.currency.Code != EUR {
+		t.Errorf("Expected %d %s got %v (%v)", 100, USD, u, err)
+	}
+
+	if _, err := m.WithCurrency("UNKNOWN"); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+}
+
+func TestMoney_AllocateDecimal(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		ratios   []string
+		expected []int64
+	}{
+		{100, []string{"33.33", "66.67"}, []int64{34, 66}},
+		{10000, []string{"33.33", "66.67"}, []int64{3333, 6667}},
+		{100, []string{"0.5", "0.25", "0.25"}, []int64{50, 25, 25}},
+		{100, []string{"1", "1.5"}, []int64{40, 60}},
+		{100, []string{"33.3", "33.3", "33.3"}, []int64{34, 33, 33}},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		ms, err := m.AllocateDecimal(tc.ratios...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, p := range ms {
+			if p.amount != tc.expected[i] {
+				t.Errorf("Expected allocation of %d by %v to give %d got %d", tc.amount, tc.ratios, tc.expected[i], p.amount)
+			}
+		}
+	}
+
+	m, _ := New(100, EUR)
+	for _, rs := range [][]string{{"1", "abc"}, {"1", "-1.5"}, {"1.2.3"}, {"+1"}, {""}} {
+		if _, err := m.AllocateDecimal(rs...); err == nil {
+			t.Errorf("Expected error for ratios %v", rs)
+		}
+	}
+}
+
+func TestMoney_AllocateFloat(t *testing.T) {
+	m, _ := New(10000, EUR)
+	ms, err := m.AllocateFloat(33.33, 66.67)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ms[0].amount != 3333 || ms[1].amount != 6667 {
+		t.Errorf("Expected 3333 and 6667 got %d and %d", ms[0].amount, ms[1].amount)
+	}
+
+	if _, err := m.AllocateFloat(1, math.NaN()); err == nil {
+		t.Error("Expected error for NaN ratio")
+	}
+
+	if _, err := m.AllocateFloat(1, math.Inf(1)); err == nil {
+		t.Error("Expected error for infinite ratio")
+	}
+}
+
+func TestMoney_AllocateFixed(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		fixed    []int64
+		ratios   []int
+		expected []int64
+	}{
+		{1000, []int64{30}, []int{90, 10}, []int64{30, 873, 97}},
+		{1000, []int64{30, 70}, []int{1, 1, 1}, []int64{30, 70, 300, 300, 300}},
+		{1000, []int64{1000}, []int{1}, []int64{1000, 0}},
+		{1000, nil, []int{1, 1, 1}, []int64{334, 333, 333}},
+		{-1000, []int64{-100}, []int{1, 1}, []int64{-100, -450, -450}},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		fixed := make([]*Money, len(tc.fixed))
+		for i, f := range tc.fixed {
+			fixed[i], _ = New(f, EUR)
+		}
+
+		ms, err := m.AllocateFixed(fixed, tc.ratios...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(ms) != len(tc.expected) {
+			t.Fatalf("Expected %d parties got %d", len(tc.expected), len(ms))
+		}
+
+		for i, p := range ms {
+			if p.amount != tc.expected[i] {
+				t.Errorf("Expected party %d of %d to get %d got %d", i, tc.amount, tc.expected[i], p.amount)
+			}
+		}
+	}
+}
+
+func TestMoney_AllocateFixed2(t *testing.T) {
+	m, _ := New(1000, EUR)
+	tooMuch, _ := New(1001, EUR)
+	usd, _ := New(10, USD)
+	fee, _ := New(10, EUR)
+
+	if _, err := m.AllocateFixed([]*Money{tooMuch}, 1); err == nil {
+		t.Error("Expected error for fixed amounts exceeding total")
+	}
+
+	if _, err := m.AllocateFixed([]*Money{usd}, 1); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := m.AllocateFixed([]*Money{fee}); err == nil {
+		t.Error("Expected error for missing ratios")
+	}
+}
+
+func TestMoney_AllocateBy(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		weights  []int64
+		expected []int64
+	}{
+		{1000, []int64{2500, 7500}, []int64{250, 750}},
+		{100, []int64{1999, 1999, 1999}, []int64{34, 33, 33}},
+		{100, []int64{0, 500}, []int64{0, 100}},
+		// Weights summing up beyond an int64.
+		{1000, []int64{4e18, 1e18, 7e18}, []int64{334, 83, 583}},
+		{1000, []int64{math.MaxInt64, math.MaxInt64, 1}, []int64{500, 500, 0}},
+		{-1000, []int64{4e18, 1e18, 7e18}, []int64{-334, -83, -583}},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		weights := make([]*Money, len(tc.weights))
+		for i, w := range tc.weights {
+			weights[i], _ = New(w, USD)
+		}
+
+		ms, err := m.AllocateBy(weights...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, p := range ms {
+			if p.amount != tc.expected[i] || p.currency.Code != EUR {
+				t.Errorf("Expected party %d of %d to get %d EUR got %d %s", i, tc.amount, tc.expected[i], p.amount, p.currency.Code)
+			}
+		}
+	}
+}
+
+func TestMoney_AllocateBy2(t *testing.T) {
+	m, _ := New(100, EUR)
+	eur, _ := New(100, EUR)
+	usd, _ := New(100, USD)
+	negative, _ := New(-100, EUR)
+
+	if _, err := m.AllocateBy(eur, usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := m.AllocateBy(eur, negative); err == nil {
+		t.Error("Expected error for negative weight")
+	}
+
+	if _, err := m.AllocateBy(); err == nil {
+		t.Error("Expected error for missing weights")
+	}
+}
+
+func TestMoney_AllocateDetailed(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		ratios   []int
+		shares   []int64
+		leftover int64
+		extra    []int
+	}{
+		{100, []int{1, 1, 1}, []int64{34, 33, 33}, 1, []int{0}},
+		{101, []int{1, 1, 1}, []int64{34, 34, 33}, 2, []int{0, 1}},
+		{-101, []int{1, 1, 1}, []int64{-34, -34, -33}, -2, []int{0, 1}},
+		{100, []int{1, 1}, []int64{50, 50}, 0, nil},
+		{100, []int{0, 0}, []int64{0, 0}, 0, nil},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		r, err := m.AllocateDetailed(tc.ratios...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, p := range r.Shares {
+			if p.amount != tc.shares[i] {
+				t.Errorf("Expected party %d of %d to get %d got %d", i, tc.amount, tc.shares[i], p.amount)
+			}
+		}
+
+		if r.Leftover.amount != tc.leftover || r.Leftover.currency.Code != EUR {
+			t.Errorf("Expected leftover of %d got %d", tc.leftover, r.Leftover.amount)
+		}
+
+		if !reflect.DeepEqual(r.Extra, tc.extra) {
+			t.Errorf("Expected extra units for parties %v got %v", tc.extra, r.Extra)
+		}
+	}
+}
+
+func TestMoney_SplitWithStrategy(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		strategy RemainderStrategy
+		expected []int64
+	}{
+		{102, RemainderRoundRobin, []int64{21, 21, 20, 20, 20}},
+		{102, RemainderReverseRoundRobin, []int64{20, 20, 20, 21, 21}},
+		{102, RemainderFirst, []int64{22, 20, 20, 20, 20}},
+		{102, RemainderLast, []int64{20, 20, 20, 20, 22}},
+		{-102, RemainderReverseRoundRobin, []int64{-20, -20, -20, -21, -21}},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		ms, err := m.SplitWithStrategy(5, tc.strategy)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, p := range ms {
+			if p.amount != tc.expected[i] {
+				t.Errorf("Expected party %d of %d to get %d got %d", i, tc.amount, tc.expected[i], p.amount)
+			}
+		}
+	}
+
+	m, _ := New(100, EUR)
+	if _, err := m.SplitWithStrategy(0, RemainderFirst); err == nil {
+		t.Error("Expected error for zero parties")
+	}
+
+	if _, err := m.SplitWithStrategy(3, RemainderStrategy(42)); err == nil {
+		t.Error("Expected error for unknown strategy")
+	}
+}
+
+func TestMoney_SplitRandom(t *testing.T) {
+	m, _ := New(1003, EUR)
+	a, err := m.SplitRandom(10, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, _ := m.SplitRandom(10, 42)
+	var sum int64
+	var extra int
+	for i := range a {
+		sum += a[i].amount
+		if a[i].amount == 101 {
+			extra++
+		}
+
+		if a[i].amount != b[i].amount {
+			t.Errorf("Expected same seed to give same split got %d and %d", a[i].amount, b[i].amount)
+		}
+	}
+
+	if sum != 1003 || extra != 3 {
+		t.Errorf("Expected 3 parties with an extra penny summing to 1003 got %d and %d", extra, sum)
+	}
+
+	if _, err := m.SplitRandom(0, 42); err == nil {
+		t.Error("Expected error for zero parties")
+	}
+}
+
+func TestMoney_SplitWithCap(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		cap      int64
+		expected []int64
+	}{
+		{25000, 10000, []int64{8334, 8333, 8333}},
+		{20000, 10000, []int64{10000, 10000}},
+		{9999, 10000, []int64{9999}},
+		{0, 10000, []int64{0}},
+		{-25000, 10000, []int64{-8334, -8333, -8333}},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		cap, _ := New(tc.cap, EUR)
+		ms, err := m.SplitWithCap(cap, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(ms) != len(tc.expected) {
+			t.Fatalf("Expected %d parts got %d", len(tc.expected), len(ms))
+		}
+
+		for i, p := range ms {
+			if p.amount != tc.expected[i] {
+				t.Errorf("Expected part %d of %d to be %d got %d", i, tc.amount, tc.expected[i], p.amount)
+			}
+		}
+	}
+
+	m, _ := New(100, EUR)
+	zero, _ := New(0, EUR)
+	cent, _ := New(1, EUR)
+	usd, _ := New(100, USD)
+
+	if _, err := m.SplitWithCap(zero, 10); err == nil {
+		t.Error("Expected error for zero cap")
+	}
+
+	if _, err := m.SplitWithCap(cent, 0); err == nil {
+		t.Error("Expected error for no parts")
+	}
+
+	for _, amount := range []int64{101, math.MaxInt64, math.MinInt64} {
+		large, _ := New(amount, EUR)
+		if _, err := large.SplitWithCap(cent, 100); err == nil {
+			t.Errorf("Expected error for %d needing more than 100 parts", amount)
+		}
+	}
+
+	if _, err := m.SplitWithCap(usd, 10); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestMoney_SplitWithMinimum(t *testing.T) {
+	m, _ := New(1000, EUR)
+	min, _ := New(300, EUR)
+
+	ms, err := m.SplitWithMinimum(3, min)
+	if err != nil || len(ms) != 3 || ms[2].amount != 333 {
+		t.Errorf("Expected 3 parties of at least 333 got %v (%v)", ms, err)
+	}
+
+	if _, err := m.SplitWithMinimum(4, min); !errors.Is(err, ErrBelowMinimum) {
+		t.Errorf("Expected %v got %v", ErrBelowMinimum, err)
+	}
+
+	// -5 splits into -3 and -2, the first part being below the minimum.
+	refund, _ := New(-5, EUR)
+	floor, _ := New(-2, EUR)
+	if _, err := refund.SplitWithMinimum(2, floor); !errors.Is(err, ErrBelowMinimum) {
+		t.Errorf("Expected %v got %v", ErrBelowMinimum, err)
+	}
+
+	usd, _ := New(300, USD)
+	if _, err := m.SplitWithMinimum(3, usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestMoney_SplitUpToMinimum(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		n        int
+		min      int64
+		expected []int64
+	}{
+		{1000, 5, 300, []int64{334, 333, 333}},
+		{1000, 2, 300, []int64{500, 500}},
+		{300, 5, 300, []int64{300}},
+		{1000, 3, 0, []int64{334, 333, 333}},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		min, _ := New(tc.min, EUR)
+		ms, err := m.SplitUpToMinimum(tc.n, min)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(ms) != len(tc.expected) {
+			t.Fatalf("Expected %d parties got %d", len(tc.expected), len(ms))
+		}
+
+		for i, p := range ms {
+			if p.amount != tc.expected[i] {
+				t.Errorf("Expected party %d of %d to get %d got %d", i, tc.amount, tc.expected[i], p.amount)
+			}
+		}
+	}
+
+	m, _ := New(299, EUR)
+	min, _ := New(300, EUR)
+	if _, err := m.SplitUpToMinimum(3, min); !errors.Is(err, ErrBelowMinimum) {
+		t.Errorf("Expected %v got %v", ErrBelowMinimum, err)
+	}
+
+	if _, err := m.SplitUpToMinimum(0, min); err == nil {
+		t.Error("Expected error for zero parties")
+	}
+}
+
+func TestMoney_AllocateWithMinimum(t *testing.T) {
+	m, _ := New(1000, EUR)
+	min, _ := New(100, EUR)
+
+	ms, err := m.AllocateWithMinimum(min, 80, 20, 0)
+	if err != nil || ms[0].amount != 800 || ms[1].amount != 200 || ms[2].amount != 0 {
+		t.Errorf("Expected 800, 200 and 0 got %v (%v)", ms, err)
+	}
+
+	if _, err := m.AllocateWithMinimum(min, 95, 5); !errors.Is(err, ErrBelowMinimum) {
+		t.Errorf("Expected %v got %v", ErrBelowMinimum, err)
+	}
+}
+
+func TestMoney_MultiplyRational(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		n, d     int64
+		mode     RoundingMode
+		expected int64
+	}{
+		{100, 1, 3, RoundHalfUp, 33},
+		{100, 2, 3, RoundHalfUp, 67},
+		{100, 2, 3, RoundDown, 66},
+		{-100, 2, 3, RoundFloor, -67},
+		{100, -2, 3, RoundCeiling, -66},
+		{25, 1, 10, RoundHalfEven, 2},
+		{math.MaxInt64 / 2, 3, 4, RoundHalfUp, 3458764513820540927},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		r, err := m.MultiplyRational(tc.n, tc.d, tc.mode)
+
+		if err != nil || r.amount != tc.expected {
+			t.Errorf("Expected %d * %d / %d to be %d got %v (%v)", tc.amount, tc.n, tc.d, tc.expected, r, err)
+		}
+	}
+
+	m, _ := New(math.MaxInt64/2, EUR)
+	if _, err := m.MultiplyRational(3, 1, RoundHalfUp); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if _, err := m.MultiplyRational(1, 0, RoundHalfUp); err == nil {
+		t.Error("Expected error for division by zero")
+	}
+
+	if _, err := m.MultiplyRational(1, 2, RoundingMode(42)); err == nil {
+		t.Error("Expected error for unknown rounding mode")
+	}
+}
+
+func TestMoney_MultiplyFloat(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		f        float64
+		mode     RoundingMode
+		expected int64
+	}{
+		{100, 1.15, RoundDown, 115},
+		{1000, 0.075, RoundHalfUp, 75},
+		{999, 0.5, RoundHalfEven, 500},
+		{999, 0.5, RoundHalfDown, 499},
+		{100, -2, RoundHalfUp, -200},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		r, err := m.MultiplyFloat(tc.f, tc.mode)
+
+		if err != nil || r.amount != tc.expected {
+			t.Errorf("Expected %d * %f to be %d got %v (%v)", tc.amount, tc.f, tc.expected, r, err)
+		}
+	}
+
+	m, _ := New(100, EUR)
+	if _, err := m.MultiplyFloat(math.NaN(), RoundHalfUp); err == nil {
+		t.Error("Expected error for NaN multiplier")
+	}
+}
+
+func TestMoney_DivideExact(t *testing.T) {
+	m, _ := New(1200, EUR)
+
+	r, err := m.DivideExact(12)
+	if err != nil || r.amount != 100 {
+		t.Errorf("Expected %d got %v (%v)", 100, r, err)
+	}
+
+	r, err = m.DivideExact(-4)
+	if err != nil || r.amount != -300 {
+		t.Errorf("Expected %d got %v (%v)", -300, r, err)
+	}
+
+	if _, err := m.DivideExact(7); !errors.Is(err, ErrInexactDivision) {
+		t.Errorf("Expected %v got %v", ErrInexactDivision, err)
+	}
+
+	if _, err := m.DivideExact(0); err == nil {
+		t.Error("Expected error for division by zero")
+	}
+}
@@ -0,0 +1,134 @@
+// Package moneytest provides helpers for tests of code using money.
+//
+//	func TestCheckout(t *testing.T) {
+//		total := checkout(moneytest.Parse(t, "EUR 9.99"), 2)
+//		moneytest.AssertEqual(t, moneytest.Parse(t, "EUR 19.98"), total)
+//	}
+package moneytest
+
+import (
+	"math/rand"
+	"testing"
+
+	money "github.com/bluelabs-eu/go-money"
+)
+
+// New returns new Money, failing the test if the currency is unknown.
+func New(t testing.TB, amount int64, currencyCode string) *money.Money {
+	t.Helper()
+
+	m, err := money.New(amount, currencyCode)
+	if err != nil {
+		t.Fatalf("moneytest: %v", err)
+	}
+
+	return m
+}
+
+// Parse returns Money written like "EUR 12.34", failing the test if it's invalid.
+func Parse(t testing.TB, s string) *money.Money {
+	t.Helper()
+
+	m := &money.Money{}
+	if err := m.UnmarshalText([]byte(s)); err != nil {
+		t.Fatalf("moneytest: %v", err)
+	}
+
+	return m
+}
+
+// List returns Money of the given currency for every amount, failing the test if the currency is unknown.
+func List(t testing.TB, currencyCode string, amounts ...int64) []*money.Money {
+	t.Helper()
+
+	ms := make([]*money.Money, len(amounts))
+	for i, a := range amounts {
+		ms[i] = New(t, a, currencyCode)
+	}
+
+	return ms
+}
+
+// Rand returns random Money of the given currency, or of a random listed currency if currencyCode is empty,
+// with amounts distributed like testing/quick generates them, boundary values included.
+// It fails the test if the currency is unknown.
+func Rand(t testing.TB, r *rand.Rand, currencyCode string) *money.Money {
+	t.Helper()
+
+	m := (&money.Money{}).Generate(r, 0).Interface().(*money.Money)
+	if currencyCode == "" {
+		return m
+	}
+
+	m, err := m.WithCurrency(currencyCode)
+	if err != nil {
+		t.Fatalf("moneytest: %v", err)
+	}
+
+	return m
+}
+
+// AssertEqual reports an error like "want €10.00, got €9.99" if got doesn't equal want.
+// Currencies are compared too, and shown along with the amounts if they differ.
+func AssertEqual(t testing.TB, want, got *money.Money) bool {
+	t.Helper()
+
+	if equal(want, got) {
+		return true
+	}
+
+	t.Errorf("want %s, got %s", describe(want, got), describe(got, want))
+	return false
+}
+
+// AssertAllEqual reports an error for every position at which got doesn't equal want, or if their lengths differ.
+func AssertAllEqual(t testing.TB, want, got []*money.Money) bool {
+	t.Helper()
+
+	if len(want) != len(got) {
+		t.Errorf("want %d amounts, got %d", len(want), len(got))
+		return false
+	}
+
+	ok := true
+	for i := range want {
+		if !equal(want[i], got[i]) {
+			t.Errorf("at %d: want %s, got %s", i, describe(want[i], got[i]), describe(got[i], want[i]))
+			ok = false
+		}
+	}
+
+	return ok
+}
+
+// AssertCurrency reports an error if got isn't of the given currency.
+func AssertCurrency(t testing.TB, want string, got *money.Money) bool {
+	t.Helper()
+
+	if got != nil && got.CurrencyCode() == want {
+		return true
+	}
+
+	t.Errorf("want currency %s, got %s", want, describe(got, nil))
+	return false
+}
+
+func equal(want, got *money.Money) bool {
+	if want == nil || got == nil {
+		return want == got
+	}
+
+	return want.SameCurrency(got) && want.AmountUnformatted() == got.AmountUnformatted()
+}
+
+// describe displays m, adding its currency code if other is of a different currency.
+func describe(m, other *money.Money) string {
+	switch {
+	case m == nil:
+		return "nil"
+	case other != nil && !m.SameCurrency(other):
+		return m.Display() + " (" + m.CurrencyCode() + ")"
+	}
+
+	return m.Display()
+}
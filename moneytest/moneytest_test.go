@@ -0,0 +1,123 @@
+package moneytest
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	money "github.com/bluelabs-eu/go-money"
+)
+
+// recorder records the failures reported to it instead of failing the test.
+type recorder struct {
+	testing.TB
+	errors []string
+	fatal  bool
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...interface{}) {
+	r.Errorf(format, args...)
+	r.fatal = true
+}
+
+func TestAssertEqual(t *testing.T) {
+	tcs := []struct {
+		want     *money.Money
+		got      *money.Money
+		expected string
+	}{
+		{New(t, 1000, money.EUR), New(t, 1000, money.EUR), ""},
+		{nil, nil, ""},
+		{New(t, 1000, money.EUR), New(t, 999, money.EUR), "want €10.00, got €9.99"},
+		{New(t, 1000, money.USD), New(t, 1000, money.CAD), "want $10.00 (USD), got $10.00 (CAD)"},
+		{New(t, 1000, money.EUR), nil, "want €10.00, got nil"},
+	}
+
+	for _, tc := range tcs {
+		r := &recorder{}
+		ok := AssertEqual(r, tc.want, tc.got)
+
+		if tc.expected == "" {
+			if !ok || len(r.errors) != 0 {
+				t.Errorf("Expected no error got %v", r.errors)
+			}
+			continue
+		}
+
+		if ok || len(r.errors) != 1 || r.errors[0] != tc.expected {
+			t.Errorf("Expected %q got %v", tc.expected, r.errors)
+		}
+	}
+}
+
+func TestAssertAllEqual(t *testing.T) {
+	r := &recorder{}
+	if !AssertAllEqual(r, List(t, money.EUR, 1, 2), List(t, money.EUR, 1, 2)) || len(r.errors) != 0 {
+		t.Errorf("Expected no error got %v", r.errors)
+	}
+
+	r = &recorder{}
+	if AssertAllEqual(r, List(t, money.EUR, 1, 2, 3), List(t, money.EUR, 1, 3, 4)) || len(r.errors) != 2 {
+		t.Errorf("Expected 2 errors got %v", r.errors)
+	}
+
+	if r.errors[0] != "at 1: want €0.02, got €0.03" {
+		t.Errorf("Expected %q got %q", "at 1: want €0.02, got €0.03", r.errors[0])
+	}
+
+	r = &recorder{}
+	if AssertAllEqual(r, List(t, money.EUR, 1), nil) || len(r.errors) != 1 {
+		t.Errorf("Expected 1 error got %v", r.errors)
+	}
+}
+
+func TestAssertCurrency(t *testing.T) {
+	r := &recorder{}
+	if !AssertCurrency(r, money.EUR, Parse(t, "EUR 1")) || len(r.errors) != 0 {
+		t.Errorf("Expected no error got %v", r.errors)
+	}
+
+	if AssertCurrency(r, money.EUR, Parse(t, "GBP 1")) || r.errors[0] != "want currency EUR, got £1.00" {
+		t.Errorf("Expected error got %v", r.errors)
+	}
+}
+
+func TestBuilders(t *testing.T) {
+	if m := Parse(t, "EUR 12.34"); m.AmountUnformatted() != 1234 || m.CurrencyCode() != money.EUR {
+		t.Errorf("Expected %d %s got %d %s", 1234, money.EUR, m.AmountUnformatted(), m.CurrencyCode())
+	}
+
+	r := &recorder{}
+	New(r, 1, "XXX")
+	Parse(r, "twelve")
+	List(r, "XXX", 1)
+
+	if !r.fatal || len(r.errors) != 3 {
+		t.Errorf("Expected 3 fatal errors got %v", r.errors)
+	}
+}
+
+func TestRand(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	codes := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		AssertCurrency(t, money.JPY, Rand(t, r, money.JPY))
+		codes[Rand(t, r, "").CurrencyCode()] = true
+	}
+
+	if len(codes) < 10 {
+		t.Errorf("Expected random currencies got %v", codes)
+	}
+
+	rec := &recorder{}
+	Rand(rec, r, "XXX")
+	if !rec.fatal {
+		t.Error("Expected fatal error for invalid currency")
+	}
+}
@@ -0,0 +1,102 @@
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+const (
+	// mysqlMaxScale is the largest number of decimal places of MySQL DECIMAL(p,s) columns.
+	mysqlMaxScale = 30
+	// mysqlAmountDigits is the number of digits of the largest Amount, 9223372036854775807.
+	mysqlAmountDigits = 19
+)
+
+// MySQLDecimalType returns the MySQL column type holding every amount of the currency exactly, like
+// "DECIMAL(19,2)" for EUR or "DECIMAL(19,0)" for JPY, for use in migrations. The scale is the currency's
+// fraction, so that stored values keep the currency's decimal places. Columns of a single currency can
+// be read and written with Column in the SQLAmount format as well.
+func MySQLDecimalType(currencyCode string) (string, error) {
+	currency := GetCurrency(currencyCode)
+	if currency == nil {
+		return "", fmt.Errorf("invalid currency '%s'", currencyCode)
+	}
+
+	if currency.Fraction > mysqlMaxScale {
+		return "", fmt.Errorf("mysql DECIMAL can't hold more than %d decimal places", mysqlMaxScale)
+	}
+
+	precision := mysqlAmountDigits
+	if currency.Fraction > precision {
+		precision = currency.Fraction
+	}
+
+	return fmt.Sprintf("DECIMAL(%d,%d)", precision, currency.Fraction), nil
+}
+
+// MySQLAmount is the exact text of a MySQL DECIMAL value, like "12.3400", as returned by the driver as []byte.
+// Scanning into it never goes through float64, unlike scanning DECIMAL columns into numbers.
+// NULL is read as the empty string and the empty string is stored as NULL.
+type MySQLAmount string
+
+// Value implements driver.Valuer.
+func (a MySQLAmount) Value() (driver.Value, error) {
+	if a == "" {
+		return nil, nil
+	}
+
+	return string(a), nil
+}
+
+// Scan implements sql.Scanner.
+func (a *MySQLAmount) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*a = ""
+		return nil
+	case string:
+		*a = MySQLAmount(v)
+		return nil
+	case []byte:
+		*a = MySQLAmount(v)
+		return nil
+	}
+
+	return fmt.Errorf("can't scan %T into mysql amount", src)
+}
+
+// MySQLColumns stores Money in paired DECIMAL amount and currency columns, the amount in major units
+// like "12.34", tagged for sqlx. Nest it into a struct to name the columns after the field, selecting
+// them with aliases like price_amount AS "price.amount":
+//
+//	type Order struct {
+//		ID    int64
+//		Price money.MySQLColumns `db:"price"`
+//	}
+//
+// Amount columns with a larger scale than the currency's fraction are read as long as the extra
+// decimal places are zeros.
+type MySQLColumns struct {
+	Amount   MySQLAmount `db:"amount"`
+	Currency string      `db:"currency"`
+}
+
+// MySQLColumns returns Money as paired DECIMAL amount and currency columns.
+func (m *Money) MySQLColumns() (MySQLColumns, error) {
+	c := m.currency.get()
+	if err := c.assertSmallestUnit(); err != nil {
+		return MySQLColumns{}, err
+	}
+
+	return MySQLColumns{Amount: MySQLAmount(m.AmountFixed()), Currency: c.Code}, nil
+}
+
+// Money creates and returns new Money from paired DECIMAL amount and currency columns.
+func (c MySQLColumns) Money() (*Money, error) {
+	if c.Currency == "" {
+		return nil, errors.New("money columns have no currency")
+	}
+
+	return parseSignedAmount(trimDecimalZeros(string(c.Amount)), c.Currency, ".", "")
+}
@@ -0,0 +1,128 @@
+package money
+
+import (
+	"database/sql/driver"
+	"math"
+	"testing"
+)
+
+func TestMySQLDecimalType(t *testing.T) {
+	defer Restore(Snapshot())
+	AddCurrency("WEI18", "Ξ", "$1", ".", ",", 18)
+	AddCurrency("YOCTO", "y", "$1", ".", ",", 24)
+	AddCurrency("PLANCK", "p", "$1", ".", ",", 31)
+
+	tcs := []struct {
+		code     string
+		expected string
+	}{
+		{EUR, "DECIMAL(19,2)"},
+		{JPY, "DECIMAL(19,0)"},
+		{BHD, "DECIMAL(19,3)"},
+		{"WEI18", "DECIMAL(19,18)"},
+		{"YOCTO", "DECIMAL(24,24)"},
+	}
+
+	for _, tc := range tcs {
+		typ, err := MySQLDecimalType(tc.code)
+		if err != nil || typ != tc.expected {
+			t.Errorf("Expected %s got %s, %v", tc.expected, typ, err)
+		}
+	}
+
+	for _, code := range []string{"PLANCK", "XYZ"} {
+		if _, err := MySQLDecimalType(code); err == nil {
+			t.Errorf("Expected error for %s", code)
+		}
+	}
+}
+
+func TestMySQLAmount(t *testing.T) {
+	tcs := []struct {
+		src      interface{}
+		expected MySQLAmount
+		value    driver.Value
+	}{
+		{[]byte("12.3400"), "12.3400", "12.3400"},
+		{"-0.05", "-0.05", "-0.05"},
+		{nil, "", nil},
+	}
+
+	for _, tc := range tcs {
+		a := MySQLAmount("1")
+		if err := a.Scan(tc.src); err != nil || a != tc.expected {
+			t.Errorf("Expected %q got %q, %v", tc.expected, a, err)
+		}
+
+		if v, err := a.Value(); err != nil || v != tc.value {
+			t.Errorf("Expected %v got %v, %v", tc.value, v, err)
+		}
+	}
+
+	var a MySQLAmount
+	if err := a.Scan(12.34); err == nil {
+		t.Error("Expected error for float64")
+	}
+}
+
+func TestMoney_MySQLColumns(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected MySQLColumns
+	}{
+		{1234, EUR, MySQLColumns{"12.34", EUR}},
+		{-5, USD, MySQLColumns{"-0.05", USD}},
+		{1000, JPY, MySQLColumns{"1000", JPY}},
+		{math.MinInt64, BHD, MySQLColumns{"-9223372036854775.808", BHD}},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		c, err := m.MySQLColumns()
+		if err != nil || c != tc.expected {
+			t.Errorf("Expected %v got %v, %v", tc.expected, c, err)
+		}
+
+		back, err := c.Money()
+		if err != nil || *back != *m {
+			t.Errorf("Expected %v got %v, %v", m, back, err)
+		}
+	}
+
+	micros, _ := NewFromMicros(1, EUR)
+	if _, err := micros.MySQLColumns(); err == nil {
+		t.Error("Expected error for amount in micros")
+	}
+}
+
+func TestMySQLColumns_Money(t *testing.T) {
+	tcs := []struct {
+		columns  MySQLColumns
+		expected string
+		err      bool
+	}{
+		{MySQLColumns{"12.340000", EUR}, "€12.34", false},
+		{MySQLColumns{"1000.00", JPY}, "¥1000", false},
+		{MySQLColumns{"-0.0500", USD}, "-$0.05", false},
+		{MySQLColumns{"12.345", EUR}, "", true},
+		{MySQLColumns{"", EUR}, "", true},
+		{MySQLColumns{"12.34", ""}, "", true},
+		{MySQLColumns{"12.34", "XYZ"}, "", true},
+		{MySQLColumns{"1e3", EUR}, "", true},
+	}
+
+	for _, tc := range tcs {
+		m, err := tc.columns.Money()
+		if tc.err {
+			if err == nil {
+				t.Errorf("Expected error for %v", tc.columns)
+			}
+			continue
+		}
+
+		if err != nil || m.Display() != tc.expected {
+			t.Errorf("Expected %s got %v, %v", tc.expected, m, err)
+		}
+	}
+}
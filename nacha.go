@@ -0,0 +1,48 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// maxNACHAAmount is the highest amount fitting into the 10 digits of a NACHA entry amount field.
+const maxNACHAAmount = 9999999999
+
+// NACHAAmount returns Money as the amount field of a NACHA ACH entry: 10 zero-padded digits in cents.
+// It fails unless Money is a non-negative USD amount fitting into the field.
+func (m *Money) NACHAAmount() (string, error) {
+	if m.currency.get() != GetCurrency(USD) {
+		return "", fmt.Errorf("ach amounts must be in %s, got '%s'", USD, m.CurrencyCode())
+	}
+
+	if m.amount < 0 {
+		return "", errors.New("ach amounts can't be negative")
+	}
+
+	if m.amount > maxNACHAAmount {
+		return "", errors.New("ach amounts can't have more than 10 digits")
+	}
+
+	return fmt.Sprintf("%010d", m.amount), nil
+}
+
+// ParseNACHAAmount creates and returns new USD Money from the 10 digits amount field of a NACHA ACH entry.
+func ParseNACHAAmount(amount string) (*Money, error) {
+	if len(amount) != 10 {
+		return nil, fmt.Errorf("invalid ach amount '%s', expected 10 digits", amount)
+	}
+
+	for _, c := range amount {
+		if c < '0' || c > '9' {
+			return nil, fmt.Errorf("invalid ach amount '%s', expected 10 digits", amount)
+		}
+	}
+
+	a, err := strconv.ParseInt(amount, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(a, USD)
+}
@@ -0,0 +1,44 @@
+package money
+
+import "testing"
+
+func TestMoney_NACHAAmount(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		expected string
+	}{
+		{1234, "0000001234"},
+		{0, "0000000000"},
+		{9999999999, "9999999999"},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, USD)
+		r, err := m.NACHAAmount()
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected %s got %s, %v", tc.expected, r, err)
+		}
+
+		p, err := ParseNACHAAmount(r)
+		if err != nil || *p != *m {
+			t.Errorf("Expected %s to parse to %v got %v, %v", r, m, p, err)
+		}
+	}
+
+	eur, _ := New(1, EUR)
+	negative, _ := New(-1, USD)
+	large, _ := New(10000000000, USD)
+	micros, _ := NewFromMicros(1, USD)
+
+	for _, m := range []*Money{eur, negative, large, micros} {
+		if _, err := m.NACHAAmount(); err == nil {
+			t.Errorf("Expected error for %v", m)
+		}
+	}
+
+	for _, s := range []string{"1234", "00000012345", "-000001234", "00000012a4", ""} {
+		if _, err := ParseNACHAAmount(s); err == nil {
+			t.Errorf("Expected error for %q", s)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// DatedMoney is an amount due on a date, like a cash flow of a loan: negative for money paid out
+// and positive for money received.
+type DatedMoney struct {
+	Date   time.Time
+	Amount *Money
+}
+
+const (
+	// irrPlaces is the number of decimal places of the rates returned by InternalRateOfReturn.
+	irrPlaces = 9
+	// irrIterations bounds the bisection of InternalRateOfReturn, which takes about 55 halvings.
+	irrIterations = 200
+	// irrTolerance is the width the bisection of InternalRateOfReturn narrows down to.
+	irrTolerance = 1e-12
+)
+
+// NPV returns the net present value of the cash flows at the given annual discount rate, as of the date of
+// the first flow. Flows are discounted by (1 + rate) ^ (days / 365) like XNPV of spreadsheets, so they don't
+// need to be evenly spaced or sorted. The discounting is done in float64, precise to about 15 significant
+// digits, and the result is rounded half away from zero to the currency's smallest unit.
+func NPV(rate Rate, flows []DatedMoney) (*Money, error) {
+	if err := validateFlows(flows); err != nil {
+		return nil, err
+	}
+
+	if err := rate.validate(); err != nil {
+		return nil, err
+	}
+
+	r := rate.Float64()
+	if r <= -1 {
+		return nil, errors.New("discount rate must be higher than -100%")
+	}
+
+	v := math.Round(xnpv(r, flows))
+	if v >= math.MaxInt64 || v < math.MinInt64 {
+		return nil, ErrOverflow
+	}
+
+	return &Money{amount: int64(v), currency: flows[0].Amount.currency}, nil
+}
+
+// InternalRateOfReturn returns the internal rate of return of the cash flows, like XIRR of spreadsheets:
+// the annual rate at which their NPV is zero. It's searched by bisection between -100% and 1,000,000%,
+// to within 1e-12 or the precision of float64 above 1000, and returned rounded half away from zero to
+// 9 decimal places, e.g. Rate{123456789, 1000000000} for 12.3456789%. The flows need at least one positive and one negative amount, and fail to converge when
+// their NPV doesn't change sign in the range.
+func InternalRateOfReturn(flows []DatedMoney) (Rate, error) {
+	if err := validateFlows(flows); err != nil {
+		return Rate{}, err
+	}
+
+	var in, out bool
+	for _, f := range flows {
+		in = in || f.Amount.IsPositive()
+		out = out || f.Amount.IsNegative()
+	}
+
+	if !in || !out {
+		return Rate{}, errors.New("cash flows need positive and negative amounts")
+	}
+
+	lo, hi := -1+1e-9, 1e4
+	flo := xnpv(lo, flows)
+	if (flo > 0) == (xnpv(hi, flows) > 0) {
+		return Rate{}, errors.New("internal rate of return doesn't converge")
+	}
+
+	for i := 0; hi-lo > irrTolerance; i++ {
+		if i == irrIterations {
+			return Rate{}, errors.New("internal rate of return doesn't converge")
+		}
+
+		mid := lo + (hi-lo)/2
+		if mid <= lo || mid >= hi {
+			// Adjacent float64s, the closest the rate gets above 1e3.
+			break
+		}
+
+		fmid := xnpv(mid, flows)
+		if fmid == 0 {
+			lo, hi = mid, mid
+			break
+		}
+
+		if (fmid > 0) == (flo > 0) {
+			lo, flo = mid, fmid
+		} else {
+			hi = mid
+		}
+	}
+
+	scale := math.Pow10(irrPlaces)
+	return Rate{Numerator: int64(math.Round((lo + (hi-lo)/2) * scale)), Denominator: int64(scale)}.normalize(), nil
+}
+
+// CashFlows are the dated flows of a loan or an investment, in one currency.
+type CashFlows []DatedMoney
+
+// NPV returns the net present value of the flows at the given annual discount rate, see NPV.
+func (fs CashFlows) NPV(rate Rate) (*Money, error) {
+	return NPV(rate, fs)
+}
+
+// IRR returns the internal rate of return of the flows, see InternalRateOfReturn. It's a method because
+// IRR is also the code of the Iranian rial.
+func (fs CashFlows) IRR() (Rate, error) {
+	return InternalRateOfReturn(fs)
+}
+
+// validateFlows checks that there are flows, all in one currency.
+func validateFlows(flows []DatedMoney) error {
+	if len(flows) == 0 {
+		return errors.New("no cash flows")
+	}
+
+	for _, f := range flows {
+		if f.Amount == nil {
+			return errors.New("cash flow has no amount")
+		}
+
+		if err := flows[0].Amount.assertSameCurrency(f.Amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// xnpv returns the net present value of the flows in the currency's smallest unit at rate r,
+// as of the date of the first flow.
+func xnpv(r float64, flows []DatedMoney) float64 {
+	var v float64
+	for _, f := range flows {
+		years := float64(Days(flows[0].Date, f.Date)) / 365
+		v += float64(f.Amount.amount) / math.Pow(1+r, years)
+	}
+
+	return v
+}
@@ -0,0 +1,112 @@
+package money
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// flows are the cash flows of the XNPV and XIRR examples of spreadsheets.
+func flows() []DatedMoney {
+	fs := []struct {
+		date   time.Time
+		amount int64
+	}{
+		{date(2008, time.January, 1), -1000000},
+		{date(2008, time.March, 1), 275000},
+		{date(2008, time.October, 30), 425000},
+		{date(2009, time.February, 15), 325000},
+		{date(2009, time.April, 1), 275000},
+	}
+
+	ds := make([]DatedMoney, len(fs))
+	for i, f := range fs {
+		ds[i].Date = f.date
+		ds[i].Amount, _ = New(f.amount, USD)
+	}
+
+	return ds
+}
+
+func TestNPV(t *testing.T) {
+	tcs := []struct {
+		rate     Rate
+		expected string
+	}{
+		{Rate{9, 100}, "$2086.65"},
+		{Rate{}, "$3000.00"},
+		{Rate{373362535, 1000000000}, "$0.00"},
+	}
+
+	for _, tc := range tcs {
+		m, err := NPV(tc.rate, flows())
+		if err != nil || m.Display() != tc.expected {
+			t.Errorf("Expected %s at %v got %v, %v", tc.expected, tc.rate, m, err)
+		}
+	}
+}
+
+func TestInternalRateOfReturn(t *testing.T) {
+	r, err := InternalRateOfReturn(flows())
+	if err != nil || r != (Rate{373362534, 1000000000}).normalize() {
+		t.Errorf("Expected 37.3362534%% got %v, %v", r, err)
+	}
+
+	start, _ := New(-10000, EUR)
+	end, _ := New(11000, EUR)
+	r, err = InternalRateOfReturn([]DatedMoney{{date(2023, time.January, 1), start}, {date(2024, time.January, 1), end}})
+	if err != nil || r != (Rate{1, 10}) {
+		t.Errorf("Expected 10%% got %v, %v", r, err)
+	}
+
+	end, _ = New(90000000, EUR)
+	r, err = CashFlows{{date(2023, time.January, 1), start}, {date(2024, time.January, 1), end}}.IRR()
+	if err != nil || r != (Rate{8999, 1}) {
+		t.Errorf("Expected 899900%% got %v, %v", r, err)
+	}
+}
+
+func TestNPV_Errors(t *testing.T) {
+	eur, _ := New(100, EUR)
+	mixed := append(flows(), DatedMoney{date(2009, time.May, 1), eur})
+
+	if _, err := NPV(Rate{9, 100}, mixed); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := NPV(Rate{9, 100}, nil); err == nil {
+		t.Error("Expected error for no flows")
+	}
+
+	if _, err := NPV(Rate{-1, 1}, flows()); err == nil {
+		t.Error("Expected error for rate of -100%")
+	}
+
+	if _, err := NPV(Rate{1, 0}, flows()); err == nil {
+		t.Error("Expected error for invalid rate")
+	}
+
+	if _, err := InternalRateOfReturn([]DatedMoney{{date(2023, time.January, 1), eur}, {date(2024, time.January, 1), eur}}); err == nil {
+		t.Error("Expected error for flows without negative amount")
+	}
+
+	if _, err := InternalRateOfReturn([]DatedMoney{{date(2023, time.January, 1), eur}, {date(2023, time.January, 1), eur.Negative().Multiply(2)}}); err == nil {
+		t.Error("Expected error for flows without rate of return")
+	}
+
+	if _, err := InternalRateOfReturn([]DatedMoney{{Date: date(2023, time.January, 1)}}); err == nil {
+		t.Error("Expected error for flow without amount")
+	}
+}
+
+func TestCashFlows(t *testing.T) {
+	m, err := CashFlows(flows()).NPV(Rate{9, 100})
+	if err != nil || m.Display() != "$2086.65" {
+		t.Errorf("Expected $2086.65 got %v, %v", m, err)
+	}
+
+	r, err := CashFlows(flows()).IRR()
+	if err != nil || r != (Rate{373362534, 1000000000}).normalize() {
+		t.Errorf("Expected 37.3362534%% got %v, %v", r, err)
+	}
+}
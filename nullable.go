@@ -0,0 +1,37 @@
+package money
+
+// Coalesce returns m, or zero Money of the given currency if m is nil.
+// It's meant for optional monetary fields, e.g. a nil *Money unmarshalled from JSON null.
+func Coalesce(m *Money, currencyCode string) (*Money, error) {
+	if m != nil {
+		return m, nil
+	}
+
+	return New(0, currencyCode)
+}
+
+// AddNullable returns the sum of m and om treating a nil operand as zero of the other operand's currency.
+// If both are nil the result is nil as well, as there's no currency to create zero Money of.
+func AddNullable(m, om *Money) (*Money, error) {
+	switch {
+	case m == nil:
+		return om, nil
+	case om == nil:
+		return m, nil
+	}
+
+	return m.Add(om)
+}
+
+// SubtractNullable returns m minus om treating a nil operand as zero of the other operand's currency.
+// If both are nil the result is nil as well, as there's no currency to create zero Money of.
+func SubtractNullable(m, om *Money) (*Money, error) {
+	switch {
+	case om == nil:
+		return m, nil
+	case m == nil:
+		return &Money{amount: mutate.calc.subtract(0, om.amount), currency: om.currency}, nil
+	}
+
+	return m.Subtract(om)
+}
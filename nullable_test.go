@@ -0,0 +1,77 @@
+package money
+
+import "testing"
+
+func TestCoalesce(t *testing.T) {
+	m, _ := New(100, EUR)
+	r, err := Coalesce(m, USD)
+	if err != nil || r != m {
+		t.Errorf("Expected %v got %v", m, r)
+	}
+
+	r, err = Coalesce(nil, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !r.IsZero() || r.currency.Code != USD {
+		t.Errorf("Expected zero %s got %d %s", USD, r.amount, r.currency.Code)
+	}
+
+	if _, err := Coalesce(nil, "UNKNOWN"); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+}
+
+func TestAddNullable(t *testing.T) {
+	a, _ := New(100, EUR)
+	b, _ := New(25, EUR)
+
+	tcs := []struct {
+		m        *Money
+		om       *Money
+		add      int64
+		subtract int64
+	}{
+		{a, b, 125, 75},
+		{a, nil, 100, 100},
+		{nil, b, 25, -25},
+	}
+
+	for _, tc := range tcs {
+		r, err := AddNullable(tc.m, tc.om)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.amount != tc.add || r.currency.Code != EUR {
+			t.Errorf("Expected %d got %d", tc.add, r.amount)
+		}
+
+		r, err = SubtractNullable(tc.m, tc.om)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.amount != tc.subtract || r.currency.Code != EUR {
+			t.Errorf("Expected %d got %d", tc.subtract, r.amount)
+		}
+	}
+
+	if r, err := AddNullable(nil, nil); r != nil || err != nil {
+		t.Errorf("Expected nil got %v, %v", r, err)
+	}
+
+	if r, err := SubtractNullable(nil, nil); r != nil || err != nil {
+		t.Errorf("Expected nil got %v, %v", r, err)
+	}
+
+	gbp, _ := New(1, GBP)
+	if _, err := AddNullable(a, gbp); err != ErrCurrencyMismatch {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := SubtractNullable(a, gbp); err != ErrCurrencyMismatch {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
@@ -0,0 +1,111 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// NumericKind is a fixed-point column type of a cloud database, as read and written by its Go client as *big.Rat.
+type NumericKind int
+
+const (
+	// SpannerNumeric is the Spanner NUMERIC type, with 38 digits of which 9 are decimal places.
+	SpannerNumeric NumericKind = iota
+	// BigQueryNumeric is the BigQuery NUMERIC type, with 38 digits of which 9 are decimal places.
+	BigQueryNumeric
+	// BigQueryBigNumeric is the BigQuery BIGNUMERIC type, with 76 digits of which 38 are decimal places.
+	BigQueryBigNumeric
+)
+
+func (k NumericKind) String() string {
+	switch k {
+	case SpannerNumeric:
+		return "spanner NUMERIC"
+	case BigQueryNumeric:
+		return "bigquery NUMERIC"
+	case BigQueryBigNumeric:
+		return "bigquery BIGNUMERIC"
+	}
+
+	return fmt.Sprintf("NumericKind(%d)", int(k))
+}
+
+// limits returns the number of decimal places and the range of the type.
+func (k NumericKind) limits() (scale int, min, max *big.Rat, err error) {
+	switch k {
+	case SpannerNumeric, BigQueryNumeric:
+		// ±(10^29 - 10^-9)
+		max = new(big.Rat).SetInt(pow10(29))
+		max.Sub(max, new(big.Rat).SetFrac(big.NewInt(1), pow10(9)))
+		return 9, new(big.Rat).Neg(max), max, nil
+	case BigQueryBigNumeric:
+		// -2^255 / 10^38 to (2^255 - 1) / 10^38
+		p := new(big.Int).Lsh(big.NewInt(1), 255)
+		min = new(big.Rat).SetFrac(new(big.Int).Neg(p), pow10(38))
+		max = new(big.Rat).SetFrac(p.Sub(p, big.NewInt(1)), pow10(38))
+		return 38, min, max, nil
+	}
+
+	return 0, nil, nil, fmt.Errorf("unknown numeric kind %d", int(k))
+}
+
+// pow10 returns 10^n.
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// Numeric returns Money in major units as the *big.Rat value of a column of the given kind,
+// e.g. 12.34 for €12.34. Money with more decimal places than the type allows fails unless the
+// extra ones are zeros.
+func (m *Money) Numeric(kind NumericKind) (*big.Rat, error) {
+	scale, _, _, err := kind.limits()
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(big.Rat).SetFrac(big.NewInt(m.amount), pow10(m.currency.get().Fraction))
+	if !fitsScale(r, scale) {
+		return nil, fmt.Errorf("%s can't hold more than %d decimal places", kind, scale)
+	}
+
+	return r, nil
+}
+
+// FromNumeric creates and returns new Money from the *big.Rat value of a column of the given kind,
+// rounded to the currency's fraction using mode. Values the type can't hold are rejected,
+// and ones out of the range of Money fail with ErrOverflow.
+func FromNumeric(r *big.Rat, currencyCode string, kind NumericKind, mode RoundingMode) (*Money, error) {
+	currency := GetCurrency(currencyCode)
+	if currency == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", currencyCode)
+	}
+
+	if err := mode.validate(); err != nil {
+		return nil, err
+	}
+
+	scale, min, max, err := kind.limits()
+	if err != nil {
+		return nil, err
+	}
+
+	if r == nil {
+		return nil, fmt.Errorf("invalid %s value", kind)
+	}
+
+	if !fitsScale(r, scale) || r.Cmp(min) < 0 || r.Cmp(max) > 0 {
+		return nil, fmt.Errorf("%s value %s out of range", kind, r.FloatString(scale))
+	}
+
+	a, err := roundRat(new(big.Rat).Mul(r, new(big.Rat).SetInt(pow10(currency.Fraction))), mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: a, currency: currency}, nil
+}
+
+// fitsScale tells whether r has at most scale decimal places.
+func fitsScale(r *big.Rat, scale int) bool {
+	return new(big.Rat).Mul(r, new(big.Rat).SetInt(pow10(scale))).IsInt()
+}
@@ -0,0 +1,122 @@
+package money
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestMoney_Numeric(t *testing.T) {
+	AddCurrency("WEI18", "Ξ", "$1", ".", ",", 18)
+
+	tcs := []struct {
+		amount   int64
+		code     string
+		kind     NumericKind
+		expected string
+	}{
+		{1234, EUR, SpannerNumeric, "12.34"},
+		{-5, USD, BigQueryNumeric, "-0.05"},
+		{1000, JPY, BigQueryNumeric, "1000"},
+		{12345, BHD, SpannerNumeric, "12.345"},
+		{math.MinInt64, EUR, SpannerNumeric, "-92233720368547758.08"},
+		{1, "WEI18", BigQueryBigNumeric, "0.000000000000000001"},
+		{1000000000, "WEI18", SpannerNumeric, "0.000000001"},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		r, err := m.Numeric(tc.kind)
+		if err != nil {
+			t.Errorf("Unexpected error for %d %s: %v", tc.amount, tc.code, err)
+			continue
+		}
+
+		expected, _ := new(big.Rat).SetString(tc.expected)
+		if r.Cmp(expected) != 0 {
+			t.Errorf("Expected %s got %s", tc.expected, r.RatString())
+		}
+
+		back, err := FromNumeric(r, tc.code, tc.kind, RoundHalfEven)
+		if err != nil || *back != *m {
+			t.Errorf("Expected %s to read back as %v got %v, %v", r.RatString(), m, back, err)
+		}
+	}
+
+	wei, _ := New(1, "WEI18")
+	if _, err := wei.Numeric(SpannerNumeric); err == nil {
+		t.Error("Expected error for more decimal places than NUMERIC holds")
+	}
+
+	if _, err := wei.Numeric(NumericKind(42)); err == nil {
+		t.Error("Expected error for unknown kind")
+	}
+}
+
+func TestFromNumeric(t *testing.T) {
+	tcs := []struct {
+		value    string
+		code     string
+		kind     NumericKind
+		mode     RoundingMode
+		expected int64
+	}{
+		{"12.345", EUR, SpannerNumeric, RoundHalfUp, 1235},
+		{"12.345", EUR, SpannerNumeric, RoundHalfEven, 1234},
+		{"-0.005", USD, BigQueryNumeric, RoundHalfUp, -1},
+		{"0.000000001", EUR, BigQueryNumeric, RoundUp, 1},
+		{"1234.5", JPY, BigQueryBigNumeric, RoundFloor, 1234},
+	}
+
+	for _, tc := range tcs {
+		r, _ := new(big.Rat).SetString(tc.value)
+		m, err := FromNumeric(r, tc.code, tc.kind, tc.mode)
+		if err != nil || m.AmountUnformatted() != tc.expected {
+			t.Errorf("Expected %s to be %d got %v, %v", tc.value, tc.expected, m, err)
+		}
+	}
+}
+
+func TestFromNumeric_Errors(t *testing.T) {
+	tcs := []struct {
+		value string
+		code  string
+		kind  NumericKind
+		mode  RoundingMode
+	}{
+		{"12.34", "XXX", SpannerNumeric, RoundHalfUp},
+		{"12.34", EUR, NumericKind(42), RoundHalfUp},
+		{"12.34", EUR, SpannerNumeric, RoundingMode(42)},
+		{"0.0000000001", EUR, SpannerNumeric, RoundHalfUp},
+		{"100000000000000000000000000000", EUR, BigQueryNumeric, RoundHalfUp},
+		{"100000000000000000000", EUR, BigQueryBigNumeric, RoundHalfUp},
+		{"1/3", EUR, BigQueryBigNumeric, RoundHalfUp},
+	}
+
+	for _, tc := range tcs {
+		r, _ := new(big.Rat).SetString(tc.value)
+		if _, err := FromNumeric(r, tc.code, tc.kind, tc.mode); err == nil {
+			t.Errorf("Expected error for %s as %v", tc.value, tc.kind)
+		}
+	}
+
+	r, _ := new(big.Rat).SetString("100000000000000000000")
+	if _, err := FromNumeric(r, EUR, SpannerNumeric, RoundHalfUp); err != ErrOverflow {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if _, err := FromNumeric(nil, EUR, SpannerNumeric, RoundHalfUp); err == nil {
+		t.Error("Expected error for nil value")
+	}
+
+	p := new(big.Int).Lsh(big.NewInt(1), 255)
+	min := new(big.Rat).SetFrac(new(big.Int).Neg(p), pow10(38))
+	if _, err := FromNumeric(min, EUR, BigQueryBigNumeric, RoundHalfUp); err != ErrOverflow {
+		t.Errorf("Expected lowest BIGNUMERIC to be in range and overflow Money got %v", err)
+	}
+
+	min.Sub(min, new(big.Rat).SetFrac(big.NewInt(1), pow10(38)))
+	if _, err := FromNumeric(min, EUR, BigQueryBigNumeric, RoundHalfUp); err == nil || err == ErrOverflow {
+		t.Errorf("Expected below lowest BIGNUMERIC to be out of range got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package money
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// OFXAmount returns Money as an OFX amount like the value of TRNAMT: signed, negative for debits,
+// with a dot decimal separator and no grouping, e.g. "-12.34". The currency goes into CURDEF.
+func (m *Money) OFXAmount() string {
+	return m.AmountFixed()
+}
+
+// ParseOFXAmount creates and returns new Money from an OFX amount, which may have a sign and use
+// either a dot or a comma as decimal separator, like "-12.34", "+5" or "12,34".
+func ParseOFXAmount(amount string, currencyCode string) (*Money, error) {
+	return parseSignedAmount(strings.TrimSpace(amount), currencyCode, ".,", "")
+}
+
+// QIFAmountLine returns Money as the amount line of a QIF transaction, like "T-12.34":
+// signed, with a dot decimal separator and no grouping.
+func (m *Money) QIFAmountLine() string {
+	return "T" + m.OFXAmount()
+}
+
+// ParseQIFAmountLine creates and returns new Money from the amount line of a QIF transaction,
+// like "T-1,234.56" or "U-1234.56", commas being thousand separators.
+func ParseQIFAmountLine(line string, currencyCode string) (*Money, error) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "T") && !strings.HasPrefix(line, "U") {
+		return nil, fmt.Errorf("invalid qif amount line '%s'", line)
+	}
+
+	return parseSignedAmount(line[1:], currencyCode, ".", ",")
+}
+
+// parseSignedAmount parses an amount with an optional sign, using any of the decimal characters as decimal
+// separator and ignoring the thousand characters, rejecting more decimal places than the currency has.
+func parseSignedAmount(amount, currencyCode, decimal, thousand string) (*Money, error) {
+	currency := GetCurrency(currencyCode)
+	if currency == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", currencyCode)
+	}
+
+	s := strings.TrimPrefix(amount, "+")
+	negative := len(s) == len(amount) && strings.HasPrefix(s, "-")
+	if negative {
+		s = s[1:]
+	}
+
+	var digits strings.Builder
+	point, decimals, integers := false, 0, 0
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9':
+			digits.WriteRune(c)
+			if point {
+				decimals++
+			} else {
+				integers++
+			}
+		case strings.ContainsRune(decimal, c) && !point:
+			point = true
+		case strings.ContainsRune(thousand, c) && !point && integers > 0:
+		default:
+			return nil, fmt.Errorf("invalid amount '%s'", amount)
+		}
+	}
+
+	if integers+decimals == 0 {
+		return nil, fmt.Errorf("invalid amount '%s'", amount)
+	}
+
+	if decimals > currency.Fraction {
+		return nil, fmt.Errorf("amount '%s' has more than %d decimal places", amount, currency.Fraction)
+	}
+
+	a := digits.String() + strings.Repeat("0", currency.Fraction-decimals)
+	if negative {
+		a = "-" + a
+	}
+
+	parsed, err := strconv.ParseInt(a, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount '%s'", amount)
+	}
+
+	return &Money{amount: parsed, currency: currency}, nil
+}
@@ -0,0 +1,105 @@
+package money
+
+import "testing"
+
+func TestMoney_OFXAmount(t *testing.T) {
+	tcs := []struct {
+		amount int64
+		code   string
+		ofx    string
+		qif    string
+	}{
+		{-1234, EUR, "-12.34", "T-12.34"},
+		{123456789, USD, "1234567.89", "T1234567.89"},
+		{-5, USD, "-0.05", "T-0.05"},
+		{1000, JPY, "1000", "T1000"},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		if r := m.OFXAmount(); r != tc.ofx {
+			t.Errorf("Expected %s got %s", tc.ofx, r)
+		}
+
+		if r := m.QIFAmountLine(); r != tc.qif {
+			t.Errorf("Expected %s got %s", tc.qif, r)
+		}
+
+		if r, err := ParseOFXAmount(tc.ofx, tc.code); err != nil || *r != *m {
+			t.Errorf("Expected %s to parse to %v got %v, %v", tc.ofx, m, r, err)
+		}
+
+		if r, err := ParseQIFAmountLine(tc.qif, tc.code); err != nil || *r != *m {
+			t.Errorf("Expected %s to parse to %v got %v, %v", tc.qif, m, r, err)
+		}
+	}
+}
+
+func TestParseOFXAmount(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		expected int64
+		err      bool
+	}{
+		{"+12.34", 1234, false},
+		{"12,3", 1230, false},
+		{" -7 ", -700, false},
+		{".5", 50, false},
+		{"12.345", 0, true},
+		{"1,234.56", 0, true},
+		{"+-1", 0, true},
+		{"--1", 0, true},
+		{"", 0, true},
+		{"-", 0, true},
+		{"abc", 0, true},
+		{"99999999999999999999", 0, true},
+	}
+
+	for _, tc := range tcs {
+		m, err := ParseOFXAmount(tc.amount, USD)
+		if tc.err {
+			if err == nil {
+				t.Errorf("Expected error for %q got %v", tc.amount, m)
+			}
+			continue
+		}
+
+		if err != nil || m.amount != tc.expected {
+			t.Errorf("Expected %q to be %d got %v, %v", tc.amount, tc.expected, m, err)
+		}
+	}
+
+	if _, err := ParseOFXAmount("1", "XXX"); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+}
+
+func TestParseQIFAmountLine(t *testing.T) {
+	tcs := []struct {
+		line     string
+		expected int64
+		err      bool
+	}{
+		{"T-1,234.56", -123456, false},
+		{"U1,000", 100000, false},
+		{"T12", 1200, false},
+		{"12.34", 0, true},
+		{"T,100", 0, true},
+		{"T1.000,00", 0, true},
+		{"D12.34", 0, true},
+	}
+
+	for _, tc := range tcs {
+		m, err := ParseQIFAmountLine(tc.line, USD)
+		if tc.err {
+			if err == nil {
+				t.Errorf("Expected error for %q got %v", tc.line, m)
+			}
+			continue
+		}
+
+		if err != nil || m.amount != tc.expected {
+			t.Errorf("Expected %q to be %d got %v, %v", tc.line, tc.expected, m, err)
+		}
+	}
+}
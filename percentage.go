@@ -0,0 +1,48 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrZeroBaseline happens when a relative change is calculated from zero.
+var ErrZeroBaseline = errors.New("relative change from zero is undefined")
+
+// maxPercentagePrecision is the largest precision whose denominator, 100 * 10^precision, fits into an int64.
+const maxPercentagePrecision = 16
+
+// PercentageChange returns the relative change from one Money to the other as a Rate,
+// e.g. 10.00 to 12.50 gives Rate{2500, 10000} which is 25.00%.
+// The percentage is rounded to the given number of decimal places, at most 16, using mode. Changes whose
+// numerator doesn't fit into an int64 fail with ErrOverflow.
+//
+// The change is relative to the absolute value of from, so it's positive whenever to is greater than from.
+// A zero baseline always returns ErrZeroBaseline, even when to is zero as well.
+func PercentageChange(from, to *Money, precision int, mode RoundingMode) (Rate, error) {
+	if err := from.assertSameCurrency(to); err != nil {
+		return Rate{}, err
+	}
+
+	if precision < 0 || precision > maxPercentagePrecision {
+		return Rate{}, fmt.Errorf("precision must be between 0 and %d", maxPercentagePrecision)
+	}
+
+	if err := mode.validate(); err != nil {
+		return Rate{}, err
+	}
+
+	if from.IsZero() {
+		return Rate{}, ErrZeroBaseline
+	}
+
+	// (to - from) * d / |from|, computed exactly and rounded once.
+	d := new(big.Int).Mul(big.NewInt(100), pow10(precision))
+	diff := new(big.Int).Sub(big.NewInt(to.amount), big.NewInt(from.amount))
+	n, err := roundRat(new(big.Rat).SetFrac(diff.Mul(diff, d), new(big.Int).Abs(big.NewInt(from.amount))), mode)
+	if err != nil {
+		return Rate{}, err
+	}
+
+	return Rate{Numerator: n, Denominator: d.Int64()}, nil
+}
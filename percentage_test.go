@@ -0,0 +1,70 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestPercentageChange(t *testing.T) {
+	tcs := []struct {
+		from      int64
+		to        int64
+		precision int
+		mode      RoundingMode
+		expected  Rate
+	}{
+		{1000, 1250, 2, RoundHalfUp, Rate{2500, 10000}},
+		{1000, 750, 0, RoundHalfUp, Rate{-25, 100}},
+		{300, 400, 2, RoundHalfUp, Rate{3333, 10000}},
+		{300, 500, 2, RoundHalfUp, Rate{6667, 10000}},
+		{300, 500, 2, RoundDown, Rate{6666, 10000}},
+		{-1000, -500, 1, RoundHalfUp, Rate{500, 1000}},
+		{1000, 1000, 2, RoundHalfUp, Rate{0, 10000}},
+		// The difference and its product with the denominator exceed an int64.
+		{math.MinInt64, math.MaxInt64, 0, RoundHalfUp, Rate{200, 100}},
+		{1, 3, 16, RoundHalfUp, Rate{2000000000000000000, 1000000000000000000}},
+	}
+
+	for _, tc := range tcs {
+		from, _ := New(tc.from, EUR)
+		to, _ := New(tc.to, EUR)
+		r, err := PercentageChange(from, to, tc.precision, tc.mode)
+
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected change from %d to %d to be %v got %v (%v)", tc.from, tc.to, tc.expected, r, err)
+		}
+	}
+}
+
+func TestPercentageChange2(t *testing.T) {
+	zero, _ := New(0, EUR)
+	eur, _ := New(100, EUR)
+	usd, _ := New(100, USD)
+
+	if _, err := PercentageChange(zero, eur, 2, RoundHalfUp); !errors.Is(err, ErrZeroBaseline) {
+		t.Errorf("Expected %v got %v", ErrZeroBaseline, err)
+	}
+
+	if _, err := PercentageChange(zero, zero, 2, RoundHalfUp); !errors.Is(err, ErrZeroBaseline) {
+		t.Errorf("Expected %v got %v", ErrZeroBaseline, err)
+	}
+
+	if _, err := PercentageChange(eur, usd, 2, RoundHalfUp); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := PercentageChange(eur, eur, -1, RoundHalfUp); err == nil {
+		t.Error("Expected error for negative precision")
+	}
+
+	if _, err := PercentageChange(eur, eur, 17, RoundHalfUp); err == nil {
+		t.Error("Expected error for precision above 16")
+	}
+
+	cent, _ := New(1, EUR)
+	max, _ := New(math.MaxInt64, EUR)
+	if _, err := PercentageChange(cent, max, 2, RoundHalfUp); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
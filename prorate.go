@@ -0,0 +1,70 @@
+package money
+
+import (
+	"errors"
+	"time"
+)
+
+// ProrateUnit measures the length of the range from start to end, end excluded, in any unit.
+type ProrateUnit func(start, end time.Time) int64
+
+// Days is a ProrateUnit counting calendar days, ignoring the time of day and daylight saving time changes.
+func Days(start, end time.Time) int64 {
+	s := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	e := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	return int64(e.Sub(s) / (24 * time.Hour))
+}
+
+// Seconds is a ProrateUnit counting elapsed seconds.
+func Seconds(start, end time.Time) int64 {
+	return int64(end.Sub(start) / time.Second)
+}
+
+// Prorate returns the part of total falling into the slice of the period, proportionally to days,
+// e.g. the charge for a partial month of a subscription. End dates are excluded.
+// The result is rounded half away from zero, use ProrateSegments when several slices must add up to total exactly.
+func Prorate(total *Money, periodStart, periodEnd, sliceStart, sliceEnd time.Time) (*Money, error) {
+	return ProrateBy(Days, total, periodStart, periodEnd, sliceStart, sliceEnd)
+}
+
+// ProrateBy works like Prorate measuring ranges with the given unit.
+func ProrateBy(unit ProrateUnit, total *Money, periodStart, periodEnd, sliceStart, sliceEnd time.Time) (*Money, error) {
+	if sliceStart.Before(periodStart) || sliceEnd.After(periodEnd) || sliceEnd.Before(sliceStart) {
+		return nil, errors.New("slice must be within period")
+	}
+
+	p := unit(periodStart, periodEnd)
+	if p <= 0 {
+		return nil, errors.New("period must not be empty")
+	}
+
+	s := unit(sliceStart, sliceEnd)
+	a, err := mutate.calc.multiplyRatio(total.amount, s, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: a, currency: total.currency}, nil
+}
+
+// ProrateSegments splits total over the consecutive segments delimited by the given boundaries,
+// proportionally to their length in unit. Leftover pennies are distributed like Allocate does,
+// so the segments always add up to total exactly.
+//
+//	// Split a monthly rent at a move-out on the 10th.
+//	parts, err := money.ProrateSegments(rent, money.Days, monthStart, moveOut, monthEnd)
+func ProrateSegments(total *Money, unit ProrateUnit, boundaries ...time.Time) ([]*Money, error) {
+	if len(boundaries) < 2 {
+		return nil, errors.New("at least two boundaries required")
+	}
+
+	rs := make([]int, len(boundaries)-1)
+	for i := range rs {
+		if boundaries[i+1].Before(boundaries[i]) {
+			return nil, errors.New("boundaries must be in chronological order")
+		}
+		rs[i] = int(unit(boundaries[i], boundaries[i+1]))
+	}
+
+	return total.Allocate(rs...)
+}
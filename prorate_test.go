@@ -0,0 +1,90 @@
+package money
+
+import (
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestProrate(t *testing.T) {
+	tcs := []struct {
+		amount     int64
+		start, end time.Time
+		expected   int64
+	}{
+		{3000, date(2024, 4, 1), date(2024, 4, 11), 1000},
+		{999, date(2024, 4, 1), date(2024, 4, 11), 333},
+		{1000, date(2024, 4, 1), date(2024, 5, 1), 1000},
+		{1000, date(2024, 4, 15), date(2024, 4, 15), 0},
+		{1000, date(2024, 4, 16), date(2024, 5, 1), 500},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		r, err := Prorate(m, date(2024, 4, 1), date(2024, 5, 1), tc.start, tc.end)
+
+		if err != nil || r.amount != tc.expected {
+			t.Errorf("Expected %d prorated from %s to %s to be %d got %v (%v)", tc.amount, tc.start, tc.end, tc.expected, r, err)
+		}
+	}
+}
+
+func TestProrate2(t *testing.T) {
+	m, _ := New(1000, EUR)
+	start, end := date(2024, 4, 1), date(2024, 5, 1)
+
+	if _, err := Prorate(m, start, end, date(2024, 3, 31), end); err == nil {
+		t.Error("Expected error for slice outside of period")
+	}
+
+	if _, err := Prorate(m, start, end, date(2024, 4, 10), date(2024, 4, 9)); err == nil {
+		t.Error("Expected error for inverted slice")
+	}
+
+	if _, err := Prorate(m, start, start, start, start); err == nil {
+		t.Error("Expected error for empty period")
+	}
+
+	r, err := ProrateBy(Seconds, m, start, start.Add(time.Hour), start, start.Add(15*time.Minute))
+	if err != nil || r.amount != 250 {
+		t.Errorf("Expected %d got %v (%v)", 250, r, err)
+	}
+}
+
+func TestProrateSegments(t *testing.T) {
+	m, _ := New(1000, EUR)
+	ms, err := ProrateSegments(m, Days, date(2024, 4, 1), date(2024, 4, 11), date(2024, 4, 21), date(2024, 5, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []int64{334, 333, 333}
+	for i, p := range ms {
+		if p.amount != expected[i] {
+			t.Errorf("Expected segment %d to be %d got %d", i, expected[i], p.amount)
+		}
+	}
+
+	if _, err := ProrateSegments(m, Days, date(2024, 4, 1)); err == nil {
+		t.Error("Expected error for single boundary")
+	}
+
+	if _, err := ProrateSegments(m, Days, date(2024, 4, 2), date(2024, 4, 1)); err == nil {
+		t.Error("Expected error for unordered boundaries")
+	}
+}
+
+func TestDays(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	// March 31st 2024 is 23 hours long in Paris.
+	if d := Days(time.Date(2024, 3, 31, 0, 0, 0, 0, loc), time.Date(2024, 4, 1, 0, 0, 0, 0, loc)); d != 1 {
+		t.Errorf("Expected 1 day got %d", d)
+	}
+}
@@ -0,0 +1,184 @@
+package money
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ProtoSchema is the protobuf definition of Money as encoded by MarshalProto, the content of money.proto
+// at the root of the module. Copy it into your proto tree, or generate code from the file directly.
+const ProtoSchema = `syntax = "proto3";
+
+package money;
+
+// Money is an amount in the smallest unit of its currency, carrying the number of decimal places it was
+// counted with, so that amounts in finer units like micros keep their value on the wire. Unlike
+// google.type.Money it has no floating nanos and round-trips every int64 amount exactly.
+//
+// Generate Go code with protoc-gen-go, passing the import path of your choice, e.g.
+// --go_opt=Mmoney.proto=example.com/gen/moneypb, and convert with money.FromProto and Money.ProtoFields.
+message Money {
+  // Amount in the smallest unit of the currency, e.g. 1234 for €12.34.
+  int64 minor_units = 1;
+  // ISO 4217 currency code, empty for zero value Money.
+  string currency_code = 2;
+  // Number of decimal places minor_units is counted with, e.g. 2 for EUR or 6 for micros.
+  int32 fraction = 3;
+}
+`
+
+// Field numbers and wire types of ProtoSchema.
+const (
+	protoMinorUnits   = 1
+	protoCurrencyCode = 2
+	protoFraction     = 3
+
+	protoVarint  = 0
+	protoFixed64 = 1
+	protoBytes   = 2
+	protoFixed32 = 5
+)
+
+// ProtoMoney is implemented by the Money message generated from ProtoSchema, e.g. by protoc-gen-go, so that
+// generated code converts without this package depending on a protobuf runtime.
+//
+//	m, err := money.FromProto(order.GetTotal())
+type ProtoMoney interface {
+	GetMinorUnits() int64
+	GetCurrencyCode() string
+	GetFraction() int32
+}
+
+// FromProto creates and returns new Money from a generated message of ProtoSchema. Amounts counted with a
+// different fraction than the currency has keep it, like Money created with NewFromMicros or Rescale, and have
+// to be settled with Settle before being mixed with Money in the currency's fraction.
+// A message without currency is read as zero value Money.
+func FromProto(p ProtoMoney) (*Money, error) {
+	m, err := fromProto(p.GetMinorUnits(), p.GetCurrencyCode(), p.GetFraction())
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// ProtoFields returns the fields of the ProtoSchema message of Money, to set on a generated message.
+//
+//	pb := &moneypb.Money{}
+//	pb.MinorUnits, pb.CurrencyCode, pb.Fraction = m.ProtoFields()
+func (m *Money) ProtoFields() (minorUnits int64, currencyCode string, fraction int32) {
+	if m.currency == nil {
+		return m.amount, "", 0
+	}
+
+	return m.amount, m.CurrencyCode(), int32(m.currency.get().Fraction)
+}
+
+// MarshalProto returns Money encoded in the protobuf binary encoding of ProtoSchema, interchangeable with
+// the encoding of generated code. Fields with default values are omitted, as proto3 does.
+func (m *Money) MarshalProto() ([]byte, error) {
+	amount, code, fraction := m.ProtoFields()
+
+	b := make([]byte, 0, 3+2*binary.MaxVarintLen64+len(code))
+	if amount != 0 {
+		b = appendProtoVarint(b, protoMinorUnits<<3|protoVarint, uint64(amount))
+	}
+	if code != "" {
+		b = appendProtoVarint(b, protoCurrencyCode<<3|protoBytes, uint64(len(code)))
+		b = append(b, code...)
+	}
+	if fraction != 0 {
+		b = appendProtoVarint(b, protoFraction<<3|protoVarint, uint64(fraction))
+	}
+
+	return b, nil
+}
+
+// UnmarshalProto parses Money from the protobuf binary encoding of ProtoSchema, keeping the fraction like
+// FromProto does. Unknown fields are skipped and repeated fields keep the last value, as protobuf requires.
+func (m *Money) UnmarshalProto(b []byte) error {
+	var amount int64
+	var code string
+	var fraction int32
+
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 || key>>3 == 0 {
+			return errors.New("invalid protobuf money field")
+		}
+		b = b[n:]
+
+		var v uint64
+		switch key & 7 {
+		case protoVarint:
+			if v, n = binary.Uvarint(b); n <= 0 {
+				return errors.New("invalid protobuf money varint")
+			}
+		case protoFixed64:
+			n = 8
+		case protoBytes:
+			if v, n = binary.Uvarint(b); n <= 0 || v > uint64(len(b)-n) {
+				return errors.New("invalid protobuf money length")
+			}
+			n += int(v)
+		case protoFixed32:
+			n = 4
+		default:
+			return fmt.Errorf("unsupported protobuf wire type %d", key&7)
+		}
+
+		if n > len(b) {
+			return errors.New("truncated protobuf money")
+		}
+
+		switch key {
+		case protoMinorUnits<<3 | protoVarint:
+			amount = int64(v)
+		case protoCurrencyCode<<3 | protoBytes:
+			code = string(b[n-int(v) : n])
+		case protoFraction<<3 | protoVarint:
+			fraction = int32(v)
+		}
+		b = b[n:]
+	}
+
+	parsed, err := fromProto(amount, code, fraction)
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+// fromProto returns Money of the amount counted with fraction decimal places.
+func fromProto(amount int64, code string, fraction int32) (Money, error) {
+	if code == "" {
+		if amount != 0 {
+			return Money{}, errors.New("protobuf money has no currency")
+		}
+
+		return Money{}, nil
+	}
+
+	currency := GetCurrency(code)
+	if currency == nil {
+		return Money{}, fmt.Errorf("invalid currency '%s'", code)
+	}
+
+	if fraction < 0 || fraction > 18 {
+		return Money{}, fmt.Errorf("invalid protobuf money fraction %d", fraction)
+	}
+
+	return Money{amount: amount, currency: currency.withFraction(int(fraction))}, nil
+}
+
+// appendProtoVarint appends a protobuf field key and its varint value to b.
+func appendProtoVarint(b []byte, key, v uint64) []byte {
+	var buf [2 * binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], key)
+	n += binary.PutUvarint(buf[n:], v)
+
+	return append(b, buf[:n]...)
+}
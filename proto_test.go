@@ -0,0 +1,153 @@
+package money
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math"
+	"testing"
+)
+
+type protoMessage struct {
+	MinorUnits   int64
+	CurrencyCode string
+	Fraction     int32
+}
+
+func (p *protoMessage) GetMinorUnits() int64    { return p.MinorUnits }
+func (p *protoMessage) GetCurrencyCode() string { return p.CurrencyCode }
+func (p *protoMessage) GetFraction() int32      { return p.Fraction }
+
+func TestProtoSchema(t *testing.T) {
+	b, err := ioutil.ReadFile("money.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != ProtoSchema {
+		t.Error("Expected ProtoSchema to match money.proto")
+	}
+}
+
+func TestMoney_MarshalProto(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected []byte
+	}{
+		{1234, EUR, []byte{0x08, 0xd2, 0x09, 0x12, 0x03, 'E', 'U', 'R', 0x18, 0x02}},
+		{-1, USD, []byte{0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x03, 'U', 'S', 'D', 0x18, 0x02}},
+		{0, JPY, []byte{0x12, 0x03, 'J', 'P', 'Y'}},
+		{math.MaxInt64, EUR, []byte{0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f, 0x12, 0x03, 'E', 'U', 'R', 0x18, 0x02}},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		b, err := m.MarshalProto()
+		if err != nil || !bytes.Equal(b, tc.expected) {
+			t.Errorf("Expected %d %s to encode as %x got %x, %v", tc.amount, tc.code, tc.expected, b, err)
+		}
+
+		var u Money
+		if err := u.UnmarshalProto(b); err != nil || u != *m {
+			t.Errorf("Expected %x to decode as %v got %v, %v", b, m, u, err)
+		}
+	}
+
+	var zero Money
+	b, err := zero.MarshalProto()
+	if err != nil || len(b) != 0 {
+		t.Errorf("Expected zero value to encode as empty message got %x, %v", b, err)
+	}
+
+	m, _ := New(1, EUR)
+	if err := m.UnmarshalProto(b); err != nil || *m != zero {
+		t.Errorf("Expected zero value got %v, %v", m, err)
+	}
+}
+
+func TestMoney_UnmarshalProto(t *testing.T) {
+	tcs := []struct {
+		given    []byte
+		expected string
+	}{
+		// Micros of EUR.
+		{[]byte{0x08, 0xc0, 0x84, 0x3d, 0x12, 0x03, 'E', 'U', 'R', 0x18, 0x06}, "€1.000000"},
+		// Fields in any order, unknown fields skipped, last value wins.
+		{[]byte{0x18, 0x02, 0x22, 0x01, 'x', 0x08, 0x01, 0x29, 0, 0, 0, 0, 0, 0, 0, 0, 0x35, 0, 0, 0, 0, 0x08, 0x0c, 0x12, 0x03, 'E', 'U', 'R'}, "€0.12"},
+		// JPY counted in cents.
+		{[]byte{0x08, 0xe8, 0x07, 0x12, 0x03, 'J', 'P', 'Y', 0x18, 0x02}, "¥10.00"},
+		// Whole units of EUR without fraction.
+		{[]byte{0x08, 0x05, 0x12, 0x03, 'E', 'U', 'R'}, "€5"},
+	}
+
+	for _, tc := range tcs {
+		var m Money
+		if err := m.UnmarshalProto(tc.given); err != nil || m.Display() != tc.expected {
+			t.Errorf("Expected %x to decode as %s got %s, %v", tc.given, tc.expected, m.Display(), err)
+		}
+	}
+}
+
+func TestMoney_UnmarshalProto_Errors(t *testing.T) {
+	tcs := [][]byte{
+		{0x08},
+		{0x08, 0xff},
+		{0x12, 0x04, 'E', 'U', 'R'},
+		{0x12, 0x03, 'X', 'Y', 'Z'},
+		{0x08, 0x01},
+		{0x00, 0x01},
+		{0x0b},
+		{0x29, 0, 0},
+		{0x08, 0x01, 0x12, 0x03, 'E', 'U', 'R', 0x18, 0x13},
+		{0x08, 0x01, 0x12, 0x03, 'E', 'U', 'R', 0x18, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(1, EUR)
+		if err := m.UnmarshalProto(tc); err == nil {
+			t.Errorf("Expected error for %x", tc)
+		} else if m.Display() != "€0.01" {
+			t.Errorf("Expected unchanged money got %s", m.Display())
+		}
+	}
+}
+
+func TestFromProto(t *testing.T) {
+	micros, _ := NewFromMicros(12340000, EUR)
+	minor, code, fraction := micros.ProtoFields()
+	if minor != 12340000 || code != EUR || fraction != 6 {
+		t.Errorf("Expected 12340000 EUR 6 got %d %s %d", minor, code, fraction)
+	}
+
+	m, err := FromProto(&protoMessage{MinorUnits: minor, CurrencyCode: code, Fraction: fraction})
+	if err != nil || *m != *micros {
+		t.Errorf("Expected %v got %v, %v", micros, m, err)
+	}
+
+	m, err = FromProto(&protoMessage{})
+	if err != nil || *m != (Money{}) {
+		t.Errorf("Expected zero value got %v, %v", m, err)
+	}
+}
+
+func TestMoney_MarshalProto_Fractions(t *testing.T) {
+	micros, _ := NewFromMicros(1234567, USD)
+	eur, _ := New(123456, EUR)
+	rescaled, _ := eur.Rescale(4, RoundHalfUp)
+
+	for _, m := range []*Money{micros, rescaled} {
+		b, err := m.MarshalProto()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var u Money
+		if err := u.UnmarshalProto(b); err != nil || u != *m {
+			t.Errorf("Expected %v got %v, %v", m, u, err)
+		}
+
+		if settled, _ := u.Settle(RoundHalfUp); settled.SameCurrency(&u) {
+			t.Errorf("Expected %s not to be in the currency's fraction", u.Display())
+		}
+	}
+}
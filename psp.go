@@ -0,0 +1,94 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MinorUnitProfile describes how a payment service provider expresses amounts as integers in its API.
+// Most providers use the currency's smallest unit, but they disagree with ISO 4217 and with each other
+// on a few currencies, so integrations declare the profile of the provider they call.
+//
+//	psp := money.MinorUnitProfile{Name: "psp", Exponents: map[string]int{money.IDR: 0}}
+//	amount, err := psp.ToMinorUnits(m)
+type MinorUnitProfile struct {
+	// Name identifies the profile in errors.
+	Name string
+	// Exponents overrides the decimal places of currencies the provider doesn't express in their smallest unit.
+	Exponents map[string]int
+	// Steps lists currencies whose integer amounts must be multiples of a number, like 10 for a three
+	// decimal currency the provider only accepts rounded to two decimals.
+	Steps map[string]int64
+}
+
+// ISO4217Profile expresses all amounts in the currency's smallest unit.
+var ISO4217Profile = MinorUnitProfile{Name: "iso4217"}
+
+// exponent returns the number of decimal places the provider uses for the currency.
+func (p MinorUnitProfile) exponent(c *Currency) int {
+	if e, ok := p.Exponents[c.Code]; ok {
+		return e
+	}
+
+	if listed := GetCurrency(c.Code); listed != nil {
+		return listed.Fraction
+	}
+
+	return c.Fraction
+}
+
+// ToMinorUnits returns Money as an integer amount in the provider's unit for the currency,
+// failing instead of rounding when Money has more decimal places than the provider accepts.
+func (p MinorUnitProfile) ToMinorUnits(m *Money) (int64, error) {
+	c := m.currency.get()
+	e := p.exponent(c)
+
+	a := m.amount
+	for f := c.Fraction; f < e; f++ {
+		var err error
+		if a, err = mutate.calc.mulDivRound(a, 10, 1, RoundHalfUp); err != nil {
+			return 0, err
+		}
+	}
+
+	for f := c.Fraction; f > e; f-- {
+		if mutate.calc.modulus(a, 10) != 0 {
+			return 0, fmt.Errorf("%s amounts in %s can't have more than %d decimal places", p.Name, c.Code, e)
+		}
+		a = mutate.calc.divide(a, 10)
+	}
+
+	if step, ok := p.Steps[c.Code]; ok && mutate.calc.modulus(a, step) != 0 {
+		return 0, fmt.Errorf("%s amounts in %s must be multiples of %d", p.Name, c.Code, step)
+	}
+
+	return a, nil
+}
+
+// FromMinorUnits creates and returns new Money from an integer amount in the provider's unit for the currency.
+// The currency code may be lower case, as some providers return it.
+func (p MinorUnitProfile) FromMinorUnits(amount int64, currencyCode string) (*Money, error) {
+	currency := GetCurrency(strings.ToUpper(currencyCode))
+	if currency == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", currencyCode)
+	}
+
+	e := p.exponent(currency)
+
+	a := amount
+	for f := e; f > currency.Fraction; f-- {
+		if mutate.calc.modulus(a, 10) != 0 {
+			return nil, fmt.Errorf("%s amount %d %s has more decimal places than the currency", p.Name, amount, currency.Code)
+		}
+		a = mutate.calc.divide(a, 10)
+	}
+
+	for f := e; f < currency.Fraction; f++ {
+		var err error
+		if a, err = mutate.calc.mulDivRound(a, 10, 1, RoundHalfUp); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Money{amount: a, currency: currency}, nil
+}
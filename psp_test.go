@@ -0,0 +1,53 @@
+package money
+
+import "testing"
+
+func TestMinorUnitProfile(t *testing.T) {
+	profile := MinorUnitProfile{
+		Name:      "test",
+		Exponents: map[string]int{IDR: 0, CLP: 2},
+		Steps:     map[string]int64{IQD: 10},
+	}
+
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected int64
+		iso      int64
+	}{
+		{1234, EUR, 1234, 1234},
+		{1234, JPY, 1234, 1234},
+		{1500, BHD, 1500, 1500},
+		{500, IDR, 5, 500},
+		{5, CLP, 500, 5},
+		{12340, IQD, 12340, 12340},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		r, err := profile.ToMinorUnits(m)
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected %d %s to be %d got %d, %v", tc.amount, tc.code, tc.expected, r, err)
+		}
+
+		if f, err := profile.FromMinorUnits(r, tc.code); err != nil || *f != *m {
+			t.Errorf("Expected %d to map back to %v got %v, %v", r, m, f, err)
+		}
+
+		if r, err := ISO4217Profile.ToMinorUnits(m); err != nil || r != tc.iso {
+			t.Errorf("Expected %d %s to be %d got %d, %v", tc.amount, tc.code, tc.iso, r, err)
+		}
+	}
+
+	idr, _ := New(550, IDR)
+	iqd, _ := New(12345, IQD)
+	for _, m := range []*Money{idr, iqd} {
+		if _, err := profile.ToMinorUnits(m); err == nil {
+			t.Errorf("Expected error for %v", m)
+		}
+	}
+
+	if _, err := profile.FromMinorUnits(1, "xxx"); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+}
@@ -0,0 +1,70 @@
+package money
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// EncodeValues adds Money to query parameters as an amount and a currency parameter named after key like
+// price.amount=12.34&price.currency=EUR, the nesting of gorilla/schema, or amount and currency for an empty key.
+// It implements the Encoder interface of go-querystring, so that Money fields of structs encoded with
+// query.Values are written this way. The amount is written like AmountFixed, whatever the currency's
+// formatter, and zero value Money adds no parameters.
+func (m Money) EncodeValues(key string, v *url.Values) error {
+	if m == (Money{}) {
+		return nil
+	}
+
+	c := m.currency.get()
+	if err := c.assertSmallestUnit(); err != nil {
+		return err
+	}
+
+	amountKey, currencyKey := queryKeys(key)
+	v.Set(amountKey, m.AmountFixed())
+	v.Set(currencyKey, c.Code)
+	return nil
+}
+
+// DecodeValues reads Money written by EncodeValues from query parameters, reading zero value Money when
+// both parameters are missing. The amount is read with a dot decimal separator, like AmountFixed writes it,
+// whatever the currency's separators. Any failure is reported as *BindError, like ParseForm does.
+func (m *Money) DecodeValues(key string, v url.Values) error {
+	amountKey, currencyKey := queryKeys(key)
+	if v.Get(amountKey) == "" && v.Get(currencyKey) == "" {
+		*m = Money{}
+		return nil
+	}
+
+	code := strings.TrimSpace(v.Get(currencyKey))
+	if code == "" {
+		return &BindError{Field: currencyKey, Err: ErrMissingField}
+	}
+
+	if GetCurrency(code) == nil {
+		return &BindError{Field: currencyKey, Value: code, Err: fmt.Errorf("invalid currency '%s'", code)}
+	}
+
+	amount := strings.TrimSpace(v.Get(amountKey))
+	if amount == "" {
+		return &BindError{Field: amountKey, Err: ErrMissingField}
+	}
+
+	r, err := parseSignedAmount(amount, code, ".", "")
+	if err != nil {
+		return &BindError{Field: amountKey, Value: amount, Err: err}
+	}
+
+	*m = *r
+	return nil
+}
+
+// queryKeys returns the names of the amount and currency parameters of key.
+func queryKeys(key string) (amount, currency string) {
+	if key == "" {
+		return "amount", "currency"
+	}
+
+	return key + ".amount", key + ".currency"
+}
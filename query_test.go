@@ -0,0 +1,105 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"net/url"
+	"testing"
+)
+
+func TestMoney_EncodeValues(t *testing.T) {
+	defer Restore(Snapshot())
+	RegisterFormatter(EUR, NewFormatter(2, ",", ".", "€", "1 $"))
+
+	tcs := []struct {
+		amount   int64
+		code     string
+		key      string
+		expected string
+	}{
+		{123456, EUR, "price", "price.amount=1234.56&price.currency=EUR"},
+		{-5, USD, "", "amount=-0.05&currency=USD"},
+		{1000, JPY, "fee", "fee.amount=1000&fee.currency=JPY"},
+		{math.MinInt64, BHD, "x", "x.amount=-9223372036854775.808&x.currency=BHD"},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		v := url.Values{}
+		if err := m.EncodeValues(tc.key, &v); err != nil || v.Encode() != tc.expected {
+			t.Errorf("Expected %s got %s, %v", tc.expected, v.Encode(), err)
+		}
+
+		var back Money
+		if err := back.DecodeValues(tc.key, v); err != nil || back != *m {
+			t.Errorf("Expected %v got %v, %v", m, back, err)
+		}
+	}
+
+	v := url.Values{}
+	if err := (Money{}).EncodeValues("price", &v); err != nil || len(v) != 0 {
+		t.Errorf("Expected no parameters for zero value got %v, %v", v, err)
+	}
+
+	back, _ := New(1, EUR)
+	if err := back.DecodeValues("price", v); err != nil || *back != (Money{}) {
+		t.Errorf("Expected zero value got %v, %v", back, err)
+	}
+
+	micros, _ := NewFromMicros(1, EUR)
+	if err := micros.EncodeValues("price", &v); err == nil {
+		t.Error("Expected error for amount in micros")
+	}
+}
+
+func TestMoney_EncodeValues_CustomCurrency(t *testing.T) {
+	defer Restore(Snapshot())
+	AddCurrency("XCM", "X", "1 $", ",", ".", 2)
+
+	m, _ := New(123456, "XCM")
+	v := url.Values{}
+	if err := m.EncodeValues("p", &v); err != nil || v.Encode() != "p.amount=1234.56&p.currency=XCM" {
+		t.Errorf("Expected %s got %s, %v", "p.amount=1234.56&p.currency=XCM", v.Encode(), err)
+	}
+
+	var back Money
+	if err := back.DecodeValues("p", v); err != nil || back != *m {
+		t.Errorf("Expected %v got %v, %v", m, back, err)
+	}
+
+	v.Set("p.amount", "1.234,56")
+	if err := back.DecodeValues("p", v); err == nil {
+		t.Error("Expected error for amount with the currency's separators")
+	}
+}
+
+func TestMoney_DecodeValues_Errors(t *testing.T) {
+	tcs := []struct {
+		query string
+		field string
+	}{
+		{"price.amount=12.34", "price.currency"},
+		{"price.amount=EUR+12.34", "price.currency"},
+		{"price.amount=12.34&price.currency=+", "price.currency"},
+		{"price.currency=EUR", "price.amount"},
+		{"price.amount=12.345&price.currency=EUR", "price.amount"},
+		{"price.amount=12.34&price.currency=XXX", "price.currency"},
+		{"price.amount=+&price.currency=EUR", "price.amount"},
+		{"price.amount=1,234.56&price.currency=EUR", "price.amount"},
+	}
+
+	for _, tc := range tcs {
+		values, _ := url.ParseQuery(tc.query)
+		m, _ := New(1, EUR)
+		err := m.DecodeValues("price", values)
+
+		var be *BindError
+		if !errors.As(err, &be) || be.Field != tc.field {
+			t.Errorf("Expected BindError of %s for %s got %v", tc.field, tc.query, err)
+		}
+
+		if m.Display() != "€0.01" {
+			t.Errorf("Expected unchanged money got %s", m.Display())
+		}
+	}
+}
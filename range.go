@@ -0,0 +1,112 @@
+package money
+
+import (
+	"errors"
+)
+
+// MoneyRange is an interval of Money in one currency with inclusive bounds, like an estimate of €3.00–€7.00.
+// Arithmetic on ranges keeps track of the bounds, so estimates compose:
+//
+//	shipping, _ := money.NewMoneyRange(threeEuros, sevenEuros)
+//	handling, _ := money.NewMoneyRange(oneEuro, twoEuros)
+//	total, err := shipping.Add(handling) // €4.00–€9.00
+type MoneyRange struct {
+	min, max *Money
+}
+
+// NewMoneyRange creates and returns new MoneyRange between min and max, which must be in the same currency.
+func NewMoneyRange(min, max *Money) (*MoneyRange, error) {
+	if err := min.assertSameCurrency(max); err != nil {
+		return nil, err
+	}
+
+	if min.compare(max) == 1 {
+		return nil, errors.New("min must not be greater than max")
+	}
+
+	return &MoneyRange{min: min, max: max}, nil
+}
+
+// Min returns the lower bound of the range.
+func (r *MoneyRange) Min() *Money {
+	return r.min
+}
+
+// Max returns the upper bound of the range.
+func (r *MoneyRange) Max() *Money {
+	return r.max
+}
+
+// Spread returns the width of the range, max minus min.
+func (r *MoneyRange) Spread() (*Money, error) {
+	return r.max.SubtractAll(r.min)
+}
+
+// Contains returns boolean of whether m is within the range.
+func (r *MoneyRange) Contains(m *Money) (bool, error) {
+	return m.Between(r.min, r.max, true)
+}
+
+// Add returns new MoneyRange of all sums of a value in Self and a value in o, failing with ErrOverflow
+// instead of wrapping around.
+func (r *MoneyRange) Add(o *MoneyRange) (*MoneyRange, error) {
+	min, err := r.min.AddAll(o.min)
+	if err != nil {
+		return nil, err
+	}
+
+	max, err := r.max.AddAll(o.max)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MoneyRange{min: min, max: max}, nil
+}
+
+// Subtract returns new MoneyRange of all differences of a value in Self and a value in o, from Self's min
+// minus o's max to Self's max minus o's min, failing with ErrOverflow instead of wrapping around.
+func (r *MoneyRange) Subtract(o *MoneyRange) (*MoneyRange, error) {
+	min, err := r.min.SubtractAll(o.max)
+	if err != nil {
+		return nil, err
+	}
+
+	max, err := r.max.SubtractAll(o.min)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MoneyRange{min: min, max: max}, nil
+}
+
+// Multiply returns new MoneyRange with both bounds multiplied by mul. A negative multiplier flips the bounds,
+// so that min stays the lower one. Fails with ErrOverflow instead of wrapping around.
+func (r *MoneyRange) Multiply(mul int64) (*MoneyRange, error) {
+	min, err := mutate.calc.mulDivRound(r.min.amount, mul, 1, RoundHalfUp)
+	if err != nil {
+		return nil, err
+	}
+
+	max, err := mutate.calc.mulDivRound(r.max.amount, mul, 1, RoundHalfUp)
+	if err != nil {
+		return nil, err
+	}
+
+	if mul < 0 {
+		min, max = max, min
+	}
+
+	return &MoneyRange{
+		min: &Money{amount: min, currency: r.min.currency},
+		max: &Money{amount: max, currency: r.max.currency},
+	}, nil
+}
+
+// Display returns the range formatted for display, like "€3.00–€7.00", or a single amount when the bounds are equal.
+func (r *MoneyRange) Display() string {
+	if r.min.amount == r.max.amount {
+		return r.min.Display()
+	}
+
+	return r.min.Display() + "–" + r.max.Display()
+}
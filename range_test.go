@@ -0,0 +1,91 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func eurRange(min, max int64) *MoneyRange {
+	lo, _ := New(min, EUR)
+	hi, _ := New(max, EUR)
+	r, _ := NewMoneyRange(lo, hi)
+	return r
+}
+
+func TestNewMoneyRange(t *testing.T) {
+	lo, _ := New(700, EUR)
+	hi, _ := New(300, EUR)
+	if _, err := NewMoneyRange(lo, hi); err == nil {
+		t.Error("Expected error for min greater than max")
+	}
+
+	usd, _ := New(700, USD)
+	if _, err := NewMoneyRange(hi, usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	r := eurRange(300, 700)
+	if r.Min().Display() != "€3.00" || r.Max().Display() != "€7.00" || r.Display() != "€3.00–€7.00" {
+		t.Errorf("Expected €3.00–€7.00 got %s", r.Display())
+	}
+
+	if s, err := r.Spread(); err != nil || s.Display() != "€4.00" {
+		t.Errorf("Expected €4.00 got %v, %v", s, err)
+	}
+
+	if ok, err := r.Contains(hi); err != nil || !ok {
+		t.Errorf("Expected %s to be within %s", hi.Display(), r.Display())
+	}
+
+	if eurRange(500, 500).Display() != "€5.00" {
+		t.Errorf("Expected €5.00 got %s", eurRange(500, 500).Display())
+	}
+}
+
+func TestMoneyRange_Arithmetic(t *testing.T) {
+	shipping, handling := eurRange(300, 700), eurRange(100, 200)
+
+	tcs := []struct {
+		op       func() (*MoneyRange, error)
+		expected string
+	}{
+		{func() (*MoneyRange, error) { return shipping.Add(handling) }, "€4.00–€9.00"},
+		{func() (*MoneyRange, error) { return shipping.Subtract(handling) }, "€1.00–€6.00"},
+		{func() (*MoneyRange, error) { return handling.Subtract(shipping) }, "-€6.00–-€1.00"},
+		{func() (*MoneyRange, error) { return shipping.Multiply(3) }, "€9.00–€21.00"},
+		{func() (*MoneyRange, error) { return shipping.Multiply(-2) }, "-€14.00–-€6.00"},
+		{func() (*MoneyRange, error) { return eurRange(-100, 200).Multiply(-1) }, "-€2.00–€1.00"},
+		{func() (*MoneyRange, error) { return shipping.Multiply(0) }, "€0.00"},
+	}
+
+	for _, tc := range tcs {
+		r, err := tc.op()
+		if err != nil || r.Display() != tc.expected {
+			t.Errorf("Expected %s got %v, %v", tc.expected, r, err)
+		}
+	}
+}
+
+func TestMoneyRange_Arithmetic_Errors(t *testing.T) {
+	wide := eurRange(0, math.MaxInt64)
+	usd, _ := New(100, USD)
+	usdRange, _ := NewMoneyRange(usd, usd)
+
+	tcs := []struct {
+		op       func() (*MoneyRange, error)
+		expected error
+	}{
+		{func() (*MoneyRange, error) { return wide.Add(eurRange(1, 1)) }, ErrOverflow},
+		{func() (*MoneyRange, error) { return eurRange(math.MinInt64, 0).Subtract(eurRange(0, 1)) }, ErrOverflow},
+		{func() (*MoneyRange, error) { return wide.Multiply(2) }, ErrOverflow},
+		{func() (*MoneyRange, error) { return wide.Add(usdRange) }, ErrCurrencyMismatch},
+		{func() (*MoneyRange, error) { return wide.Subtract(usdRange) }, ErrCurrencyMismatch},
+	}
+
+	for _, tc := range tcs {
+		if _, err := tc.op(); !errors.Is(err, tc.expected) {
+			t.Errorf("Expected %v got %v", tc.expected, err)
+		}
+	}
+}
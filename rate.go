@@ -0,0 +1,78 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Rate represents a fraction of an amount, like a tax or a discount rate,
+// as Numerator/Denominator. For example Rate{21, 100} is 21% and Rate{8875, 100000} is 8.875%.
+// The zero Rate is treated as 0%.
+type Rate struct {
+	Numerator   int64
+	Denominator int64
+}
+
+// ParseRate parses a decimal string like "2.345" or "-0.5" into an exact Rate, e.g. Rate{2345, 1000}.
+func ParseRate(s string) (Rate, error) {
+	digits, places := s, 0
+	if p := strings.Index(s, "."); p != -1 {
+		digits, places = s[:p]+s[p+1:], len(s)-p-1
+	}
+
+	n, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil || places > 18 || strings.HasPrefix(digits, "+") {
+		return Rate{}, fmt.Errorf("invalid rate '%s'", s)
+	}
+
+	return Rate{Numerator: n, Denominator: int64(math.Pow10(places))}, nil
+}
+
+// IsZero returns boolean of whether the Rate is zero.
+func (r Rate) IsZero() bool {
+	return r.Numerator == 0
+}
+
+func (r Rate) validate() error {
+	if r.Denominator <= 0 && !r.IsZero() {
+		return errors.New("rate denominator must be higher than zero")
+	}
+
+	return nil
+}
+
+// normalize reduces the fraction so that equal rates compare equal.
+func (r Rate) normalize() Rate {
+	if r.IsZero() {
+		return Rate{}
+	}
+
+	a, b := mutate.calc.absolute(r.Numerator), r.Denominator
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return Rate{Numerator: r.Numerator / a, Denominator: r.Denominator / a}
+}
+
+// apply returns the given amount multiplied by the Rate,
+// rounded half away from zero to the currency's smallest unit, or ErrOverflow if it doesn't fit into an Amount.
+func (r Rate) apply(a Amount) (Amount, error) {
+	if r.IsZero() {
+		return 0, nil
+	}
+
+	return mutate.calc.multiplyRatio(a, r.Numerator, r.Denominator)
+}
+
+// Float64 returns the Rate as a float64, e.g. 0.25 for Rate{25, 100}. Use it for reporting only.
+func (r Rate) Float64() float64 {
+	if r.IsZero() {
+		return 0
+	}
+
+	return float64(r.Numerator) / float64(r.Denominator)
+}
@@ -0,0 +1,40 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestRate_Float64(t *testing.T) {
+	if f := (Rate{2500, 10000}).Float64(); f != 0.25 {
+		t.Errorf("Expected %f got %f", 0.25, f)
+	}
+
+	if f := (Rate{}).Float64(); f != 0 {
+		t.Errorf("Expected %f got %f", 0.0, f)
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	tcs := []struct {
+		s        string
+		expected Rate
+	}{
+		{"2.345", Rate{2345, 1000}},
+		{"-0.5", Rate{-5, 10}},
+		{"3", Rate{3, 1}},
+		{"0.00", Rate{0, 100}},
+	}
+
+	for _, tc := range tcs {
+		r, err := ParseRate(tc.s)
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected %s to parse as %v got %v (%v)", tc.s, tc.expected, r, err)
+		}
+	}
+
+	for _, s := range []string{"", ".", "abc", "1.2.3", "+1", "1.-5", "1e5", "0.0000000000000000001"} {
+		if _, err := ParseRate(s); err == nil {
+			t.Errorf("Expected error parsing %q", s)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package money
+
+import (
+	"errors"
+	"time"
+)
+
+// Period is the interval at which a Recurring amount is due.
+type Period int
+
+const (
+	// PerDay is a daily amount.
+	PerDay Period = iota
+	// PerWeek is a weekly amount.
+	PerWeek
+	// PerMonth is a monthly amount.
+	PerMonth
+	// PerYear is a yearly amount.
+	PerYear
+)
+
+// DayCount holds the day-count assumption used to convert between periods, as the number of days in a year.
+// Months are always a twelfth of a year and weeks are always seven days. Between dates, as by Accrue,
+// days are counted on the calendar unless ThirtyDayMonths is set.
+type DayCount struct {
+	DaysPerYear Rate
+	// ThirtyDayMonths counts every month as 30 days between dates, following the bond basis:
+	// the 31st counts as the 30th, at the end of a range only when it starts on the 30th or 31st.
+	ThirtyDayMonths bool
+}
+
+var (
+	// Actual360 assumes years of 360 days, counting actual calendar days as money market instruments do.
+	Actual360 = DayCount{DaysPerYear: Rate{360, 1}}
+	// Actual365 assumes years of 365 days.
+	Actual365 = DayCount{DaysPerYear: Rate{365, 1}}
+	// Actual36525 assumes years of 365.25 days, accounting for leap years on average.
+	Actual36525 = DayCount{DaysPerYear: Rate{1461, 4}}
+	// Thirty360 assumes years of 360 days and months of 30 days.
+	Thirty360 = DayCount{DaysPerYear: Rate{360, 1}, ThirtyDayMonths: true}
+)
+
+// validate fails for day counts without a positive number of days per year.
+func (dc DayCount) validate() error {
+	if dc.DaysPerYear.Numerator <= 0 || dc.DaysPerYear.Denominator <= 0 {
+		return errors.New("days per year must be higher than zero")
+	}
+
+	return nil
+}
+
+// days returns the number of days from start to end, end excluded.
+func (dc DayCount) days(start, end time.Time) int64 {
+	if !dc.ThirtyDayMonths {
+		return Days(start, end)
+	}
+
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 && d1 == 30 {
+		d2 = 30
+	}
+
+	return int64(360*(y2-y1) + 30*(int(m2)-int(m1)) + d2 - d1)
+}
+
+// perYear returns how many times the period occurs in a year.
+func (dc DayCount) perYear(p Period) (Rate, error) {
+	if err := dc.validate(); err != nil {
+		return Rate{}, err
+	}
+
+	switch p {
+	case PerDay:
+		return dc.DaysPerYear, nil
+	case PerWeek:
+		return Rate{dc.DaysPerYear.Numerator, dc.DaysPerYear.Denominator * 7}, nil
+	case PerMonth:
+		return Rate{12, 1}, nil
+	case PerYear:
+		return Rate{1, 1}, nil
+	}
+
+	return Rate{}, errors.New("unknown period")
+}
+
+// Recurring represents an amount due every Period, e.g. €9.99 per month.
+type Recurring struct {
+	Amount *Money
+	Period Period
+}
+
+// NewRecurring creates and returns new instance of Recurring.
+func NewRecurring(amount *Money, period Period) *Recurring {
+	return &Recurring{Amount: amount, Period: period}
+}
+
+// To returns the Recurring amount converted to the given period using the day-count assumption,
+// rounded half away from zero, e.g. €99 per year is €8.25 per month.
+func (r *Recurring) To(p Period, dc DayCount) (*Recurring, error) {
+	n, d, err := r.factor(p, dc)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := mutate.calc.multiplyRatio(r.Amount.amount, n, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recurring{Amount: &Money{amount: a, currency: r.Amount.currency}, Period: p}, nil
+}
+
+// Compare compares two Recurring amounts of the same currency over the same time span, without any rounding:
+//
+//	if r costs more than or returns (1, nil)
+//	if r costs the same as or returns (0, nil)
+//	if r costs less than or returns (-1, nil)
+func (r *Recurring) Compare(or *Recurring, dc DayCount) (int, error) {
+	if err := r.Amount.assertSameCurrency(or.Amount); err != nil {
+		return 0, err
+	}
+
+	n, d, err := or.factor(r.Period, dc)
+	if err != nil {
+		return 0, err
+	}
+
+	// Compare r.Amount with or.Amount * n / d, in 128 bits so that the products can't wrap.
+	return NewInt128(r.Amount.amount).Mul64(d).Cmp(NewInt128(or.Amount.amount).Mul64(n)), nil
+}
+
+// factor returns n and d such that an amount per r.Period multiplied by n / d is the amount per p.
+func (r *Recurring) factor(p Period, dc DayCount) (int64, int64, error) {
+	from, err := dc.perYear(r.Period)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	to, err := dc.perYear(p)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return from.Numerator * to.Denominator, from.Denominator * to.Numerator, nil
+}
@@ -0,0 +1,75 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestRecurring_To(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		from, to Period
+		dc       DayCount
+		expected int64
+	}{
+		{9900, PerYear, PerMonth, Actual365, 825},
+		{999, PerMonth, PerYear, Actual365, 11988},
+		{100, PerDay, PerYear, Actual365, 36500},
+		{100, PerDay, PerYear, Actual36525, 36525},
+		{100, PerDay, PerMonth, Thirty360, 3000},
+		{700, PerWeek, PerDay, Actual365, 100},
+		{36500, PerYear, PerWeek, Actual365, 700},
+		{999, PerMonth, PerMonth, Actual365, 999},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		r, err := NewRecurring(m, tc.from).To(tc.to, tc.dc)
+
+		if err != nil || r.Amount.amount != tc.expected || r.Period != tc.to {
+			t.Errorf("Expected %d per %d to be %d per %d got %v (%v)", tc.amount, tc.from, tc.expected, tc.to, r, err)
+		}
+	}
+}
+
+func TestRecurring_Compare(t *testing.T) {
+	monthly, _ := New(999, EUR)
+	yearly, _ := New(9900, EUR)
+	exact, _ := New(11988, EUR)
+	max, _ := New(math.MaxInt64, EUR)
+	min, _ := New(math.MinInt64, EUR)
+
+	tcs := []struct {
+		r, or    *Recurring
+		expected int
+	}{
+		{NewRecurring(monthly, PerMonth), NewRecurring(yearly, PerYear), 1},
+		{NewRecurring(yearly, PerYear), NewRecurring(monthly, PerMonth), -1},
+		{NewRecurring(exact, PerYear), NewRecurring(monthly, PerMonth), 0},
+		// Products beyond an int64.
+		{NewRecurring(max, PerYear), NewRecurring(min, PerMonth), 1},
+		{NewRecurring(min, PerDay), NewRecurring(max, PerYear), -1},
+		{NewRecurring(max, PerMonth), NewRecurring(max, PerYear), 1},
+	}
+
+	for _, tc := range tcs {
+		r, err := tc.r.Compare(tc.or, Actual365)
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected %v compared to %v to be %d got %d (%v)", tc.r, tc.or, tc.expected, r, err)
+		}
+	}
+
+	usd, _ := New(999, USD)
+	if _, err := NewRecurring(monthly, PerMonth).Compare(NewRecurring(usd, PerMonth), Actual365); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := NewRecurring(monthly, Period(42)).Compare(NewRecurring(yearly, PerMonth), Actual365); err == nil {
+		t.Error("Expected error for unknown period")
+	}
+
+	if _, err := NewRecurring(monthly, PerMonth).To(PerDay, DayCount{}); err == nil {
+		t.Error("Expected error for invalid day count")
+	}
+}
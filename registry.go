@@ -0,0 +1,125 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RegistrySnapshot is a copy of the currency registry, along with the registered formatters and display
+// overrides, taken by Snapshot.
+type RegistrySnapshot struct {
+	currencies Currencies
+	formatters map[string]*Formatter
+	overrides  map[string]DisplayOverride
+}
+
+// Snapshot returns a copy of the currency registry to be restored later with Restore, e.g. to isolate
+// tests calling AddCurrency:
+//
+//	defer money.Restore(money.Snapshot())
+func Snapshot() *RegistrySnapshot {
+	s := &RegistrySnapshot{
+		currencies: make(Currencies, len(currencies)),
+		formatters: map[string]*Formatter{},
+		overrides:  map[string]DisplayOverride{},
+	}
+
+	// Handles are updated in place when registering again, so the snapshot keeps copies.
+	for code, c := range currencies {
+		cp := *c
+		s.currencies[code] = &cp
+	}
+
+	formatters.RLock()
+	defer formatters.RUnlock()
+
+	for code, f := range formatters.m {
+		s.formatters[code] = f
+	}
+	for code, o := range formatters.overrides {
+		s.overrides[code] = o
+	}
+
+	return s
+}
+
+// Restore puts the currency registry back into the state of the snapshot, dropping the currencies,
+// formatters and display overrides added since and undoing the changes to existing ones.
+// Money created with a currency added since keeps its amount but displays with the default format.
+func Restore(s *RegistrySnapshot) {
+	for code := range currencies {
+		if _, ok := s.currencies[code]; !ok {
+			unregister(code)
+		}
+	}
+	for _, c := range s.currencies {
+		register(*c)
+	}
+
+	formatters.Lock()
+	defer formatters.Unlock()
+
+	formatters.m = make(map[string]*Formatter, len(s.formatters))
+	for code, f := range s.formatters {
+		formatters.m[code] = f
+	}
+
+	formatters.overrides = make(map[string]DisplayOverride, len(s.overrides))
+	for code, o := range s.overrides {
+		formatters.overrides[code] = o
+	}
+}
+
+// OverrideCurrency replaces the definition of a registered currency after validating it, which Money already
+// created follows, still comparing equal to new Money. Changing the fraction is refused unless force is set:
+// Money already created then keeps its fraction and stops mixing with new Money of the same currency,
+// failing with ErrCurrencyMismatch.
+func OverrideCurrency(c Currency, force bool) error {
+	if err := c.validate(); err != nil {
+		return err
+	}
+
+	old := GetCurrency(c.Code)
+	if old == nil {
+		return fmt.Errorf("invalid currency '%s'", c.Code)
+	}
+
+	if old.Fraction != c.Fraction && !force {
+		return fmt.Errorf("refusing to change fraction of %s from %d to %d", c.Code, old.Fraction, c.Fraction)
+	}
+
+	register(c)
+	return nil
+}
+
+// RemoveCurrency removes a registered currency along with its formatter and display override,
+// so that new Money can't be created in it. Money already created keeps its amount and fraction.
+func RemoveCurrency(code string) error {
+	if GetCurrency(code) == nil {
+		return fmt.Errorf("invalid currency '%s'", code)
+	}
+
+	unregister(code)
+	RegisterFormatter(code, nil)
+	SetDisplayOverride(code, DisplayOverride{})
+	return nil
+}
+
+// validate checks that the currency can be formatted and calculated with.
+func (c *Currency) validate() error {
+	switch {
+	case c.Code == "":
+		return errors.New("currency code is required")
+	case c.Fraction < 0 || c.Fraction > 18:
+		return fmt.Errorf("currency fraction %d out of range", c.Fraction)
+	case c.Fraction > 0 && c.Decimal == "":
+		return errors.New("currency decimal separator is required")
+	case c.CashRounding < 0:
+		return errors.New("currency cash rounding can't be negative")
+	case !strings.Contains(c.Template, "1"):
+		return fmt.Errorf("currency template '%s' has no amount", c.Template)
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestSnapshot(t *testing.T) {
+	eur := GetCurrency(EUR)
+	s := Snapshot()
+
+	AddCurrency("SNAP", "S", "1 $", ".", "", 2)
+	AddCurrency(EUR, "E", "1 $", ".", "", 3)
+	RegisterFormatter(USD, NewFormatter(2, ",", ".", "$", "1 $"))
+	SetDisplayOverride(GBP, DisplayOverride{Grapheme: "GBP "})
+
+	m, _ := New(1234, EUR)
+	if m.Display() != "1.234 E" {
+		t.Fatalf("Expected %s got %s", "1.234 E", m.Display())
+	}
+
+	Restore(s)
+
+	if GetCurrency("SNAP") != nil {
+		t.Error("Expected added currency to be dropped")
+	}
+
+	if GetCurrency(EUR) != eur {
+		t.Errorf("Expected EUR to be restored got %+v", GetCurrency(EUR))
+	}
+
+	tcs := []struct {
+		code     string
+		expected string
+	}{
+		{EUR, "€12.34"},
+		{USD, "$12.34"},
+		{GBP, "£12.34"},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(1234, tc.code)
+		if m.Display() != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, m.Display())
+		}
+	}
+
+	// Changes after a restore don't leak into the snapshot.
+	AddCurrency("SNAP", "S", "1 $", ".", "", 2)
+	Restore(s)
+	if GetCurrency("SNAP") != nil {
+		t.Error("Expected snapshot to be restorable more than once")
+	}
+}
+
+func TestOverrideCurrency(t *testing.T) {
+	defer Restore(Snapshot())
+
+	m, _ := New(1234, EUR)
+
+	c := *GetCurrency(EUR)
+	c.Template = "1 $"
+	if err := OverrideCurrency(c, false); err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Display() != "12.34 €" {
+		t.Errorf("Expected %s got %s", "12.34 €", m.Display())
+	}
+
+	c.Fraction = 3
+	if err := OverrideCurrency(c, false); err == nil {
+		t.Error("Expected error for changed fraction")
+	}
+
+	if GetCurrency(EUR).Fraction != 2 {
+		t.Errorf("Expected refused override to keep fraction %d got %d", 2, GetCurrency(EUR).Fraction)
+	}
+
+	if err := OverrideCurrency(c, true); err != nil {
+		t.Fatal(err)
+	}
+
+	n, _ := New(1234, EUR)
+	if m.Display() != "12.34 €" || n.Display() != "1.234 €" {
+		t.Errorf("Expected old and new Money to keep their fraction got %s and %s", m.Display(), n.Display())
+	}
+
+	if _, err := m.Add(n); err != ErrCurrencyMismatch {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	// Money of the old fraction is still shared by rescaled new Money.
+	if r, _ := n.Rescale(2, RoundHalfUp); *r != (Money{amount: 123, currency: m.currency}) {
+		t.Errorf("Expected %v to share the handle of %v", r, m)
+	}
+}
+
+func TestRegistry_ComparableAcrossRegistrations(t *testing.T) {
+	defer Restore(Snapshot())
+
+	eur, _ := New(1234, EUR)
+	micros, _ := NewFromMicros(1234, EUR)
+	sek, _ := New(1234, SEK)
+	AddCurrency("XCP", "X", "1 $", ".", "", 2)
+	custom, _ := New(1234, "XCP")
+	s := Snapshot()
+
+	c := *GetCurrency(EUR)
+	c.Grapheme = "EUR "
+	if err := OverrideCurrency(c, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := RemoveCurrency(SEK); err != nil {
+		t.Fatal(err)
+	}
+	AddCurrency(SEK, "kr", "1 $", ".", "", 2)
+	AddCurrency("XCP", "Y", "1 $", ".", "", 2)
+
+	assertComparable := func(when string) {
+		t.Helper()
+
+		newEUR, _ := New(1234, EUR)
+		newMicros, _ := NewFromMicros(1234, EUR)
+		newSEK, _ := New(1234, SEK)
+		newCustom, _ := New(1234, "XCP")
+		for i, p := range [][2]*Money{{eur, newEUR}, {micros, newMicros}, {sek, newSEK}, {custom, newCustom}} {
+			if *p[0] != *p[1] {
+				t.Errorf("Expected pair %d to == %s", i, when)
+			}
+		}
+
+		totals := map[Money]int{*eur: 1}
+		if totals[*newEUR] != 1 {
+			t.Errorf("Expected Money to stay usable as map key %s", when)
+		}
+	}
+
+	assertComparable("after registering again")
+	if eur.Display() != "EUR 12.34" || custom.Display() != "12.34 Y" {
+		t.Errorf("Expected existing Money to follow the new definition got %s and %s", eur.Display(), custom.Display())
+	}
+
+	Restore(s)
+	assertComparable("after a restore")
+	if eur.Display() != "€12.34" {
+		t.Errorf("Expected restored definition got %s", eur.Display())
+	}
+}
+
+func TestOverrideCurrency_Errors(t *testing.T) {
+	defer Restore(Snapshot())
+
+	valid := *GetCurrency(USD)
+	tcs := []func(c *Currency){
+		func(c *Currency) { c.Code = "" },
+		func(c *Currency) { c.Code = "XXX" },
+		func(c *Currency) { c.Fraction = -1 },
+		func(c *Currency) { c.Fraction = 19 },
+		func(c *Currency) { c.Decimal = "" },
+		func(c *Currency) { c.CashRounding = -5 },
+		func(c *Currency) { c.Template = "$" },
+	}
+
+	for i, change := range tcs {
+		c := valid
+		change(&c)
+		if err := OverrideCurrency(c, true); err == nil {
+			t.Errorf("Expected error for case %d", i)
+		}
+	}
+
+	if GetCurrency(USD).Template != valid.Template {
+		t.Errorf("Expected USD to be unchanged got %+v", GetCurrency(USD))
+	}
+}
+
+func TestRemoveCurrency(t *testing.T) {
+	defer Restore(Snapshot())
+
+	m, _ := New(1234, SEK)
+	SetDisplayOverride(SEK, DisplayOverride{Grapheme: ":-"})
+
+	if err := RemoveCurrency(SEK); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := New(1, SEK); err == nil {
+		t.Error("Expected error creating Money in a removed currency")
+	}
+
+	if m.Display() != "12.34 kr" || m.AmountUnformatted() != 1234 {
+		t.Errorf("Expected existing Money to keep working got %s", m.Display())
+	}
+
+	if err := RemoveCurrency(SEK); err == nil {
+		t.Error("Expected error removing a missing currency")
+	}
+
+	AddCurrency(SEK, "kr", "1 $", ".", "", 2)
+	if n, _ := New(1, SEK); n.Display() != "0.01 kr" {
+		t.Errorf("Expected display override to be removed got %s", n.Display())
+	}
+}
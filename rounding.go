@@ -0,0 +1,55 @@
+package money
+
+import (
+	"errors"
+)
+
+// RoundingMode defines how amounts are rounded when precision is lost.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest neighbour, halves away from zero.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfDown rounds to the nearest neighbour, halves towards zero.
+	RoundHalfDown
+	// RoundHalfEven rounds to the nearest neighbour, halves towards the even neighbour (banker's rounding).
+	RoundHalfEven
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundDown rounds towards zero, i.e. truncates.
+	RoundDown
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+)
+
+// away returns boolean of whether a quotient truncated towards zero is rounded away from zero. negative tells
+// whether the exact quotient is below zero, half how twice the remainder compares to the divisor, -1, 0 or 1,
+// and odd whether the truncated quotient is odd. All roundings of the package decide through it.
+func (r RoundingMode) away(negative bool, half int, odd bool) bool {
+	switch r {
+	case RoundUp:
+		return true
+	case RoundCeiling:
+		return !negative
+	case RoundFloor:
+		return negative
+	case RoundHalfUp:
+		return half >= 0
+	case RoundHalfDown:
+		return half > 0
+	case RoundHalfEven:
+		return half > 0 || half == 0 && odd
+	}
+
+	return false
+}
+
+func (r RoundingMode) validate() error {
+	if r < RoundHalfUp || r > RoundFloor {
+		return errors.New("unknown rounding mode")
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package money
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestCalculator_DivideRound(t *testing.T) {
+	tcs := []struct {
+		amount int64
+		// expected results for HalfUp, HalfDown, HalfEven, Up, Down, Ceiling, Floor
+		expected [7]int64
+	}{
+		{55, [7]int64{6, 5, 6, 6, 5, 6, 5}},
+		{25, [7]int64{3, 2, 2, 3, 2, 3, 2}},
+		{16, [7]int64{2, 2, 2, 2, 1, 2, 1}},
+		{11, [7]int64{1, 1, 1, 2, 1, 2, 1}},
+		{10, [7]int64{1, 1, 1, 1, 1, 1, 1}},
+		{-10, [7]int64{-1, -1, -1, -1, -1, -1, -1}},
+		{-11, [7]int64{-1, -1, -1, -2, -1, -1, -2}},
+		{-16, [7]int64{-2, -2, -2, -2, -1, -1, -2}},
+		{-25, [7]int64{-3, -2, -2, -3, -2, -2, -3}},
+		{-55, [7]int64{-6, -5, -6, -6, -5, -5, -6}},
+	}
+
+	modes := []RoundingMode{RoundHalfUp, RoundHalfDown, RoundHalfEven, RoundUp, RoundDown, RoundCeiling, RoundFloor}
+	for _, tc := range tcs {
+		for i, mode := range modes {
+			if r := mutate.calc.divideRound(tc.amount, 10, mode); r != tc.expected[i] {
+				t.Errorf("Expected %d / 10 rounded with mode %d to be %d got %d", tc.amount, mode, tc.expected[i], r)
+			}
+		}
+	}
+}
+
+func TestCalculator_DivideRound_LargeRemainder(t *testing.T) {
+	// Twice the remainder doesn't fit into an int64.
+	if r := mutate.calc.divideRound(math.MaxInt64-1, math.MaxInt64, RoundHalfUp); r != 1 {
+		t.Errorf("Expected %d got %d", 1, r)
+	}
+
+	if r := mutate.calc.divideRound(math.MinInt64/2+1, math.MaxInt64, RoundHalfDown); r != 0 {
+		t.Errorf("Expected %d got %d", 0, r)
+	}
+}
+
+func TestRoundingMode_Consistent(t *testing.T) {
+	modes := []RoundingMode{RoundHalfUp, RoundHalfDown, RoundHalfEven, RoundUp, RoundDown, RoundCeiling, RoundFloor}
+	for _, mode := range modes {
+		for a := int64(-30); a <= 30; a++ {
+			for _, d := range []int64{1, 2, 3, 4, 7, 10, -4} {
+				q := mutate.calc.divideRound(a, d, mode)
+				r, err := roundRat(big.NewRat(a, d), mode)
+				if err != nil || q != r {
+					t.Errorf("Expected %d / %d rounded with mode %d to agree got %d and %d (%v)", a, d, mode, q, r, err)
+				}
+			}
+		}
+	}
+}
+
+func TestRoundingMode_Validate(t *testing.T) {
+	if err := RoundFloor.validate(); err != nil {
+		t.Error(err)
+	}
+
+	if err := RoundingMode(42).validate(); err == nil {
+		t.Error("Expected error for unknown rounding mode")
+	}
+}
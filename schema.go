@@ -0,0 +1,93 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// JSONSchema returns the JSON Schema of Money as encoded by the active JSON codec, to be embedded into API specs,
+// e.g. as an OpenAPI 3.1 component, so that they match the actual marshaling. The amount pattern accepts the
+// separators of all registered currencies and the currency is one of the registered codes,
+// so the schema has to be generated after registering custom currencies. Zero value Money, encoded with
+// an empty currency, isn't part of the schema. With UnmarshalJSONStrict both fields
+// are required and other fields are rejected. Custom codecs set through the injection points have no known
+// schema and fail.
+func JSONSchema() (map[string]interface{}, error) {
+	if !sameFunc(MarshalJSON, marshalJSON) {
+		return nil, errors.New("custom json codec has no known schema")
+	}
+
+	strict := sameFunc(UnmarshalJSON, UnmarshalJSONStrict)
+	if !strict && !sameFunc(UnmarshalJSON, unmarshalJSON) {
+		return nil, errors.New("custom json codec has no known schema")
+	}
+
+	codes := make([]string, 0, len(currencies))
+	decimals, thousands := map[string]bool{}, map[string]bool{}
+	for code, c := range currencies {
+		codes = append(codes, code)
+
+		if c.Fraction > 0 {
+			decimals[c.Decimal] = true
+		}
+		if c.Thousand != "" {
+			thousands[c.Thousand] = true
+		}
+	}
+	sort.Strings(codes)
+
+	number := "[0-9]+"
+	if len(thousands) > 0 {
+		number = "[0-9]{1,3}(?:(?:" + alternation(thousands) + ")[0-9]{3})*|[0-9]+"
+	}
+
+	pattern := "^-?(?:" + number + ")"
+	if len(decimals) > 0 {
+		pattern += "(?:(?:" + alternation(decimals) + ")[0-9]+)?"
+	}
+	pattern += "$"
+
+	s := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"amount": map[string]interface{}{
+				"type":        "string",
+				"pattern":     pattern,
+				"description": "amount in major units with all decimal places of the currency",
+				"example":     "12.34",
+			},
+			"currency": map[string]interface{}{
+				"type":        "string",
+				"enum":        codes,
+				"description": "ISO 4217 currency code",
+				"example":     EUR,
+			},
+		},
+	}
+
+	if strict {
+		s["required"] = []string{"amount", "currency"}
+		s["additionalProperties"] = false
+	}
+
+	return s, nil
+}
+
+// sameFunc returns boolean of whether both functions are the same top-level function.
+func sameFunc(a, b interface{}) bool {
+	return reflect.ValueOf(a).Pointer() == reflect.ValueOf(b).Pointer()
+}
+
+// alternation returns a regular expression matching any of the strings.
+func alternation(set map[string]bool) string {
+	alts := make([]string, 0, len(set))
+	for s := range set {
+		alts = append(alts, regexp.QuoteMeta(s))
+	}
+	sort.Strings(alts)
+
+	return strings.Join(alts, "|")
+}
@@ -0,0 +1,121 @@
+package money
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestJSONSchema(t *testing.T) {
+	defer Restore(Snapshot())
+	defer defaultCodecs()()
+	for code := range currencies {
+		if code != EUR && code != JPY && code != USD {
+			delete(currencies, code)
+		}
+	}
+
+	s, err := JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := s["required"]; ok {
+		t.Errorf("Expected no required fields got %v", s["required"])
+	}
+
+	props := s["properties"].(map[string]interface{})
+	pattern := props["amount"].(map[string]interface{})["pattern"].(string)
+	if pattern != `^-?(?:[0-9]+)(?:(?:\.)[0-9]+)?$` {
+		t.Errorf("Unexpected pattern %s", pattern)
+	}
+
+	re := regexp.MustCompile(pattern)
+	codes := props["currency"].(map[string]interface{})["enum"].([]string)
+	if !reflect.DeepEqual(codes, []string{EUR, JPY, USD}) {
+		t.Errorf("Expected %v got %v", []string{EUR, JPY, USD}, codes)
+	}
+
+	for _, code := range codes {
+		m, _ := New(-123456789, code)
+		b, _ := json.Marshal(m)
+
+		var doc map[string]string
+		_ = json.Unmarshal(b, &doc)
+		if !re.MatchString(doc["amount"]) {
+			t.Errorf("Expected %s to match %s", doc["amount"], pattern)
+		}
+	}
+
+	for _, amount := range []string{"", "1.", ".5", "+1", "1,000.00", "1e3"} {
+		if re.MatchString(amount) {
+			t.Errorf("Expected %q not to match %s", amount, pattern)
+		}
+	}
+}
+
+func TestJSONSchema_Currencies(t *testing.T) {
+	defer Restore(Snapshot())
+	defer defaultCodecs()()
+	for code := range currencies {
+		if code != EUR && code != USD {
+			delete(currencies, code)
+		}
+	}
+
+	// Formatters only change the display, not the amounts of the schema.
+	RegisterFormatter(EUR, NewFormatter(2, ",", ".", "€", "1 $"))
+	AddCurrency("XCH", "Fr.", "$ 1", ".", "'", 2)
+
+	s, _ := JSONSchema()
+	props := s["properties"].(map[string]interface{})
+	pattern := props["amount"].(map[string]interface{})["pattern"].(string)
+	if expected := `^-?(?:[0-9]{1,3}(?:(?:')[0-9]{3})*|[0-9]+)(?:(?:\.)[0-9]+)?$`; pattern != expected {
+		t.Errorf("Expected %s got %s", expected, pattern)
+	}
+
+	re := regexp.MustCompile(pattern)
+	for _, tc := range []struct {
+		amount int64
+		code   string
+	}{{123456789, "XCH"}, {-123456789, EUR}, {12, EUR}, {123456789, USD}} {
+		m, _ := New(tc.amount, tc.code)
+		if !re.MatchString(m.Amount()) {
+			t.Errorf("Expected %s to match %s", m.Amount(), re)
+		}
+	}
+}
+
+func TestJSONSchema_Codecs(t *testing.T) {
+	defer defaultCodecs()()
+
+	UnmarshalJSON = UnmarshalJSONStrict
+	s, err := JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s["additionalProperties"] != false || len(s["required"].([]string)) != 2 {
+		t.Errorf("Expected strict schema got %v", s)
+	}
+
+	UnmarshalJSON = func(m *Money, b []byte) error { return nil }
+	if _, err := JSONSchema(); err == nil {
+		t.Error("Expected error for custom unmarshaling")
+	}
+
+	UnmarshalJSON = unmarshalJSON
+	MarshalJSON = func(m Money) ([]byte, error) { return nil, nil }
+	if _, err := JSONSchema(); err == nil {
+		t.Error("Expected error for custom marshaling")
+	}
+}
+
+// defaultCodecs sets the default JSON codecs and returns a function restoring the previous ones.
+func defaultCodecs() func() {
+	unmarshal, marshal := UnmarshalJSON, MarshalJSON
+	UnmarshalJSON, MarshalJSON = unmarshalJSON, marshalJSON
+
+	return func() { UnmarshalJSON, MarshalJSON = unmarshal, marshal }
+}
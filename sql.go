@@ -0,0 +1,214 @@
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SQLFormat decides how Money is stored in a single SQL column.
+type SQLFormat int
+
+const (
+	// SQLCodeAmount stores Money as a string like "EUR 12.34", as done by Value.
+	SQLCodeAmount SQLFormat = iota
+	// SQLAmount stores only the amount as a numeric string like "12.34", for columns whose currency is
+	// implied by the schema, e.g. a NUMERIC price column of a table in euros.
+	SQLAmount
+	// SQLComposite stores Money as a composite row literal of the amount in the currency's smallest unit
+	// and the currency, like "(1234,EUR)", e.g. for a PostgreSQL type created with
+	// CREATE TYPE money_value AS (amount bigint, currency char(3)).
+	SQLComposite
+)
+
+// validate fails for unknown formats.
+func (f SQLFormat) validate() error {
+	switch f {
+	case SQLCodeAmount, SQLAmount, SQLComposite:
+		return nil
+	}
+
+	return errors.New("unknown sql format")
+}
+
+// Value implements driver.Valuer, storing Money in a single string column as "EUR 12.34",
+// e.g. as a field of a GORM model. Zero value Money is stored as NULL. Amounts not in the currency's smallest
+// unit, like micros, fail as Scan couldn't read them back.
+func (m Money) Value() (driver.Value, error) {
+	if m == (Money{}) {
+		return nil, nil
+	}
+
+	if err := m.currency.get().assertSmallestUnit(); err != nil {
+		return nil, err
+	}
+
+	return m.MarshalCSV()
+}
+
+// Scan implements sql.Scanner, reading Money from a string column written by Value. NULL is read as zero value Money.
+func (m *Money) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*m = Money{}
+		return nil
+	case string:
+		return m.UnmarshalCSV(v)
+	case []byte:
+		return m.UnmarshalCSV(string(v))
+	}
+
+	return fmt.Errorf("can't scan %T into money", src)
+}
+
+// ValueAs returns Money as the value of a column in the given format. Zero value Money is stored as NULL.
+// Like Value, it fails for amounts not in the currency's smallest unit.
+func (m Money) ValueAs(f SQLFormat) (driver.Value, error) {
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+
+	if m == (Money{}) {
+		return nil, nil
+	}
+
+	if err := m.currency.get().assertSmallestUnit(); err != nil {
+		return nil, err
+	}
+
+	switch f {
+	case SQLAmount:
+		return m.AmountFixed(), nil
+	case SQLComposite:
+		c, err := m.Columns()
+		if err != nil {
+			return nil, err
+		}
+
+		return "(" + strconv.FormatInt(c.Amount, 10) + "," + c.Currency + ")", nil
+	}
+
+	return m.Value()
+}
+
+// ScanAs reads Money from a column written by ValueAs in the given format. The currency code is only used by
+// SQLAmount, whose columns don't store the currency; trailing zeros of NUMERIC columns with a larger scale
+// than the currency's fraction are ignored. NULL is read as zero value Money.
+func (m *Money) ScanAs(src interface{}, f SQLFormat, currencyCode string) error {
+	if err := f.validate(); err != nil {
+		return err
+	}
+
+	var s string
+	switch v := src.(type) {
+	case nil:
+		*m = Money{}
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("can't scan %T into money", src)
+	}
+
+	switch f {
+	case SQLAmount:
+		ref, err := parseSignedAmount(trimDecimalZeros(s), currencyCode, ".", "")
+		if err != nil {
+			return err
+		}
+
+		*m = *ref
+		return nil
+	case SQLComposite:
+		fields := strings.Split(strings.TrimSuffix(strings.TrimPrefix(s, "("), ")"), ",")
+		if len(fields) != 2 || len(s) < 2 || s[0] != '(' || s[len(s)-1] != ')' {
+			return fmt.Errorf("invalid money composite '%s'", s)
+		}
+
+		amount, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid money composite '%s'", s)
+		}
+
+		ref, err := Columns{Amount: amount, Currency: fields[1]}.Money()
+		if err != nil {
+			return err
+		}
+
+		*m = *ref
+		return nil
+	}
+
+	return m.Scan(s)
+}
+
+// Column stores Money in a single SQL column in the format chosen for that column, for schemas which
+// don't use the "EUR 12.34" strings of Value. Set Format, and Currency for SQLAmount, before scanning:
+//
+//	price := money.Column{Format: money.SQLAmount, Currency: money.EUR}
+//	err := row.Scan(&price)
+type Column struct {
+	Money    Money
+	Format   SQLFormat
+	Currency string
+}
+
+// Value implements driver.Valuer, storing the Money of the column in its format.
+func (c Column) Value() (driver.Value, error) {
+	return c.Money.ValueAs(c.Format)
+}
+
+// Scan implements sql.Scanner, reading the Money of the column in its format.
+func (c *Column) Scan(src interface{}) error {
+	return c.Money.ScanAs(src, c.Format, c.Currency)
+}
+
+// GormDataType returns the GORM data type of Money stored by Value, so that migrations create a string column.
+func (Money) GormDataType() string {
+	return "string"
+}
+
+// Columns stores Money in paired amount and currency columns, the amount in the currency's smallest unit.
+// Embed it into a GORM model with a prefix to name the columns after the field, e.g. price_amount and
+// price_currency:
+//
+//	type Order struct {
+//		ID    uint
+//		Price money.Columns `gorm:"embedded;embeddedPrefix:price_"`
+//	}
+type Columns struct {
+	Amount   int64  `gorm:"column:amount;not null"`
+	Currency string `gorm:"column:currency;size:3;not null"`
+}
+
+// Columns returns Money as paired amount and currency columns.
+func (m *Money) Columns() (Columns, error) {
+	c := m.currency.get()
+	if err := c.assertSmallestUnit(); err != nil {
+		return Columns{}, err
+	}
+
+	return Columns{Amount: m.amount, Currency: c.Code}, nil
+}
+
+// Money creates and returns new Money from paired amount and currency columns.
+func (c Columns) Money() (*Money, error) {
+	if c.Currency == "" {
+		return nil, errors.New("money columns have no currency")
+	}
+
+	return New(c.Amount, c.Currency)
+}
+
+// trimDecimalZeros drops the trailing zeros of the decimal places of a numeric string, like "12.3400" to "12.34".
+func trimDecimalZeros(s string) string {
+	if !strings.Contains(s, ".") {
+		return s
+	}
+
+	return strings.TrimSuffix(strings.TrimRight(s, "0"), ".")
+}
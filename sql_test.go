@@ -0,0 +1,194 @@
+package money
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+)
+
+var (
+	_ driver.Valuer = Money{}
+	_ sql.Scanner   = &Money{}
+)
+
+func TestMoney_Value(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected driver.Value
+	}{
+		{1234, EUR, "EUR 12.34"},
+		{-5, USD, "USD -0.05"},
+		{1000, JPY, "JPY 1000"},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		v, err := m.Value()
+		if err != nil || v != tc.expected {
+			t.Errorf("Expected %v got %v, %v", tc.expected, v, err)
+		}
+
+		for _, src := range []interface{}{v, []byte(v.(string))} {
+			var s Money
+			if err := s.Scan(src); err != nil || s != *m {
+				t.Errorf("Expected %v to scan as %v got %v, %v", src, m, s, err)
+			}
+		}
+	}
+
+	var zero Money
+	if v, err := zero.Value(); err != nil || v != nil {
+		t.Errorf("Expected zero value to be NULL got %v, %v", v, err)
+	}
+
+	m, _ := New(1, EUR)
+	if err := m.Scan(nil); err != nil || *m != zero {
+		t.Errorf("Expected NULL to scan as zero value got %v, %v", m, err)
+	}
+
+	for _, src := range []interface{}{int64(1234), "12.34", "XXX 12.34"} {
+		if err := m.Scan(src); err == nil {
+			t.Errorf("Expected error scanning %v", src)
+		}
+	}
+
+	micros, _ := NewFromMicros(1234567, USD)
+	eur, _ := New(1234, EUR)
+	rescaled, _ := eur.Rescale(4, RoundHalfUp)
+	for _, m := range []*Money{micros, rescaled} {
+		if _, err := m.Value(); err == nil {
+			t.Errorf("Expected error for %v", m)
+		}
+	}
+
+	if zero.GormDataType() != "string" {
+		t.Errorf("Expected %s got %s", "string", zero.GormDataType())
+	}
+}
+
+func TestMoney_Columns(t *testing.T) {
+	m, _ := New(-1234, EUR)
+	c, err := m.Columns()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c != (Columns{Amount: -1234, Currency: EUR}) {
+		t.Errorf("Expected %+v got %+v", Columns{Amount: -1234, Currency: EUR}, c)
+	}
+
+	if r, err := c.Money(); err != nil || *r != *m {
+		t.Errorf("Expected %v got %v, %v", m, r, err)
+	}
+
+	f, _ := reflect.TypeOf(c).FieldByName("Currency")
+	if f.Tag.Get("gorm") != "column:currency;size:3;not null" {
+		t.Errorf("Unexpected gorm tag %s", f.Tag.Get("gorm"))
+	}
+
+	micros, _ := m.ToMicros()
+	if _, err := micros.Columns(); err == nil {
+		t.Error("Expected error for amount in micros")
+	}
+
+	for _, c := range []Columns{{Amount: 1}, {Amount: 1, Currency: "XXX"}} {
+		if _, err := c.Money(); err == nil {
+			t.Errorf("Expected error for %+v", c)
+		}
+	}
+}
+
+func TestMoney_ValueAs(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		format   SQLFormat
+		expected driver.Value
+	}{
+		{1234, EUR, SQLCodeAmount, "EUR 12.34"},
+		{-123456, USD, SQLAmount, "-1234.56"},
+		{1000, JPY, SQLAmount, "1000"},
+		{-1234, EUR, SQLComposite, "(-1234,EUR)"},
+		{5, BHD, SQLComposite, "(5,BHD)"},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		c := Column{Money: *m, Format: tc.format}
+		v, err := c.Value()
+		if err != nil || v != tc.expected {
+			t.Errorf("Expected %v got %v, %v", tc.expected, v, err)
+		}
+
+		for _, src := range []interface{}{v, []byte(v.(string))} {
+			s := Column{Format: tc.format, Currency: tc.code}
+			if err := s.Scan(src); err != nil || s.Money != *m {
+				t.Errorf("Expected %v to scan as %v got %v, %v", src, m, s.Money, err)
+			}
+		}
+	}
+
+	var zero Money
+	for _, f := range []SQLFormat{SQLCodeAmount, SQLAmount, SQLComposite} {
+		if v, err := zero.ValueAs(f); err != nil || v != nil {
+			t.Errorf("Expected zero value to be NULL got %v, %v", v, err)
+		}
+	}
+
+	m, _ := New(1, EUR)
+	if _, err := m.ValueAs(SQLFormat(7)); err == nil {
+		t.Error("Expected error for unknown format")
+	}
+
+	micros, _ := m.ToMicros()
+	for _, f := range []SQLFormat{SQLCodeAmount, SQLAmount, SQLComposite} {
+		if _, err := micros.ValueAs(f); err == nil {
+			t.Errorf("Expected error for amount in micros in format %d", f)
+		}
+	}
+}
+
+func TestMoney_ScanAs(t *testing.T) {
+	tcs := []struct {
+		src      interface{}
+		format   SQLFormat
+		expected string
+	}{
+		{"12.3400", SQLAmount, "€12.34"},
+		{[]byte("-7.000"), SQLAmount, "-€7.00"},
+		{"0.0000", SQLAmount, "€0.00"},
+		{"(42,EUR)", SQLComposite, "€0.42"},
+	}
+
+	for _, tc := range tcs {
+		var m Money
+		if err := m.ScanAs(tc.src, tc.format, EUR); err != nil || m.Display() != tc.expected {
+			t.Errorf("Expected %s for %v got %s, %v", tc.expected, tc.src, m.Display(), err)
+		}
+	}
+
+	errs := []struct {
+		src    interface{}
+		format SQLFormat
+	}{
+		{"12.345", SQLAmount},
+		{"EUR 12.34", SQLAmount},
+		{12.34, SQLAmount},
+		{"(1234,EUR", SQLComposite},
+		{"(1234)", SQLComposite},
+		{"(12.34,EUR)", SQLComposite},
+		{"(1234,XXX)", SQLComposite},
+		{"(1234,EUR,1)", SQLComposite},
+		{"12.34", SQLCodeAmount},
+		{"12.34", SQLFormat(7)},
+	}
+
+	for _, tc := range errs {
+		var m Money
+		if err := m.ScanAs(tc.src, tc.format, EUR); err == nil {
+			t.Errorf("Expected error scanning %v in format %d got %v", tc.src, tc.format, m)
+		}
+	}
+}
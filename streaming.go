@@ -0,0 +1,64 @@
+package money
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// StreamingRates is a Converter reading from the latest RateTable received from a stream, like a websocket feed
+// of FX quotes adapted into a channel. Each update replaces the whole table atomically, so that Rate never
+// locks and always sees a consistent snapshot. Zero value StreamingRates has an empty table.
+type StreamingRates struct {
+	table atomic.Value
+}
+
+// NewStreamingRates creates and returns new StreamingRates starting with the given table, which may be nil.
+func NewStreamingRates(initial RateTable) *StreamingRates {
+	s := &StreamingRates{}
+	s.Update(initial)
+	return s
+}
+
+// Update swaps in a copy of the given table, so that the caller may keep changing it.
+func (s *StreamingRates) Update(t RateTable) {
+	c := make(RateTable, len(t))
+	for from, rates := range t {
+		c[from] = make(map[string]Rate, len(rates))
+		for to, r := range rates {
+			c[from][to] = r
+		}
+	}
+
+	s.table.Store(c)
+}
+
+// Consume updates the rates with every table received until the channel is closed or the context is done,
+// returning the context's error in the latter case.
+func (s *StreamingRates) Consume(ctx context.Context, updates <-chan RateTable) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case t, ok := <-updates:
+			if !ok {
+				return nil
+			}
+			s.Update(t)
+		}
+	}
+}
+
+// Snapshot returns the current table, empty if none was received yet. It must not be changed.
+func (s *StreamingRates) Snapshot() RateTable {
+	t, ok := s.table.Load().(RateTable)
+	if !ok {
+		return RateTable{}
+	}
+
+	return t
+}
+
+// Rate returns the exchange rate from one currency to another in the current table.
+func (s *StreamingRates) Rate(from, to string) (Rate, error) {
+	return s.Snapshot().Rate(from, to)
+}
@@ -0,0 +1,98 @@
+package money
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestStreamingRates(t *testing.T) {
+	s := NewStreamingRates(nil)
+	if _, err := s.Rate(EUR, USD); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected %v got %v", ErrNoRate, err)
+	}
+
+	updates := make(chan RateTable)
+	done := make(chan error)
+	go func() { done <- s.Consume(context.Background(), updates) }()
+
+	for i := int64(1); i <= 3; i++ {
+		t1 := RateTable{}
+		t1.Set(EUR, USD, Rate{100 + i, 100})
+		updates <- t1
+	}
+	close(updates)
+
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	if r, err := s.Rate(EUR, USD); err != nil || r != (Rate{103, 100}) {
+		t.Errorf("Expected %v got %v, %v", Rate{103, 100}, r, err)
+	}
+
+	// Changing an updated table doesn't affect the rates.
+	t2 := RateTable{}.Set(EUR, USD, Rate{11, 10})
+	s.Update(t2)
+	t2.Set(EUR, USD, Rate{1, 1})
+
+	m, _ := New(1000, EUR)
+	if c, _, err := m.Convert(USD, s); err != nil || c.AmountUnformatted() != 1100 {
+		t.Errorf("Expected %d got %v, %v", 1100, c, err)
+	}
+}
+
+func TestStreamingRates_ZeroValue(t *testing.T) {
+	var s StreamingRates
+	if len(s.Snapshot()) != 0 {
+		t.Errorf("Expected empty table got %v", s.Snapshot())
+	}
+
+	if _, err := s.Rate(EUR, USD); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Expected %v got %v", ErrNoRate, err)
+	}
+
+	s.Update(RateTable{}.Set(EUR, USD, Rate{11, 10}))
+	if r, err := s.Rate(EUR, USD); err != nil || r != (Rate{11, 10}) {
+		t.Errorf("Expected %v got %v, %v", Rate{11, 10}, r, err)
+	}
+}
+
+func TestStreamingRates_Consume(t *testing.T) {
+	s := NewStreamingRates(RateTable{}.Set(EUR, USD, Rate{11, 10}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := s.Consume(ctx, make(chan RateTable)); err != context.Canceled {
+		t.Errorf("Expected %v got %v", context.Canceled, err)
+	}
+
+	// Readers run concurrently with updates.
+	updates := make(chan RateTable)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				if r, err := s.Rate(USD, EUR); err != nil || r.Denominator != 11 && r.Denominator != 12 {
+					t.Errorf("Unexpected rate %v, %v", r, err)
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		for j := 0; j < 100; j++ {
+			updates <- RateTable{}.Set(EUR, USD, Rate{Numerator: 11 + int64(j%2), Denominator: 10})
+		}
+		close(updates)
+	}()
+
+	if err := s.Consume(context.Background(), updates); err != nil {
+		t.Fatal(err)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,84 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// UnmarshalJSONStrict is a strict alternative to the default money.UnmarshalJSON for services which must fail fast
+// on malformed payloads. It rejects unknown, duplicate and missing fields, field names which aren't exactly
+// "amount" and "currency", currency codes which aren't exactly a known code, and amounts with more decimal places
+// than the currency has, instead of truncating them. Opt in with
+//
+//	money.UnmarshalJSON = money.UnmarshalJSONStrict
+func UnmarshalJSONStrict(m *Money, b []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return fmt.Errorf("%w: expected object", ErrInvalidJSON)
+	}
+
+	// encoding/json matches field names case-insensitively and keeps the last duplicate,
+	// so the fields are read one by one.
+	var amount, currencyCode *jsonString
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+		}
+
+		var field **jsonString
+		switch key := tok.(string); key {
+		case "amount":
+			field = &amount
+		case "currency":
+			field = &currencyCode
+		default:
+			return fmt.Errorf("%w: unknown field '%s'", ErrInvalidJSON, key)
+		}
+
+		if *field != nil {
+			return fmt.Errorf("%w: duplicate field '%s'", ErrInvalidJSON, tok)
+		}
+
+		*field = new(jsonString)
+		if err := dec.Decode(*field); err != nil {
+			if errors.Is(err, ErrInvalidJSON) {
+				return err
+			}
+			return fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+		}
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return fmt.Errorf("%w: trailing data", ErrInvalidJSON)
+	}
+
+	if amount == nil {
+		return fmt.Errorf("%w 'amount'", ErrMissingField)
+	}
+
+	if currencyCode == nil {
+		return fmt.Errorf("%w 'currency'", ErrMissingField)
+	}
+
+	code := string(*currencyCode)
+	currency := GetCurrency(code)
+	if currency == nil {
+		return fmt.Errorf("invalid currency '%s'", code)
+	}
+
+	ref, err := parseSignedAmount(string(*amount), code, currency.Decimal, "")
+	if err != nil {
+		return err
+	}
+
+	*m = *ref
+	return nil
+}
@@ -0,0 +1,63 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUnmarshalJSONStrict(t *testing.T) {
+	tcs := []struct {
+		given    string
+		expected string
+	}{
+		{`{"amount": "12.34", "currency": "EUR"}`, "€12.34"},
+		{`{"currency": "JPY", "amount": "-5"}`, "-¥5"},
+		{`{"amount": "1.5", "currency": "USD"}`, "$1.50"},
+	}
+
+	for _, tc := range tcs {
+		var m Money
+		if err := UnmarshalJSONStrict(&m, []byte(tc.given)); err != nil || m.Display() != tc.expected {
+			t.Errorf("Expected %s for %s got %s, %v", tc.expected, tc.given, m.Display(), err)
+		}
+	}
+}
+
+func TestUnmarshalJSONStrict_Errors(t *testing.T) {
+	tcs := []struct {
+		given    string
+		expected error
+	}{
+		{`{"amount": "12.34", "currency": "EUR", "note": "x"}`, ErrInvalidJSON},
+		{`{"amount": 1234, "currency": "EUR"}`, ErrInvalidJSON},
+		{`{"amount": "12.34"}`, ErrMissingField},
+		{`{"currency": "EUR"}`, ErrMissingField},
+		{`{}`, ErrMissingField},
+		{`{"amount": "12.34", "currency": "EUR"} {}`, ErrInvalidJSON},
+		{`{"amount": "12.345", "currency": "EUR"}`, nil},
+		{`{"amount": "12.34", "currency": "eur"}`, nil},
+		{`{"amount": "12.34", "currency": "XXX"}`, nil},
+		{`{"amount": "1,234.00", "currency": "USD"}`, nil},
+		{`{"amount": "92233720368547758.08", "currency": "USD"}`, nil},
+		{`{"AMOUNT": "1.00", "Currency": "EUR"}`, ErrInvalidJSON},
+		{`{"amount": "1.00", "amount": "2.00", "currency": "EUR"}`, ErrInvalidJSON},
+		{`{"amount": "1.00", "currency": "EUR", "currency": "USD"}`, ErrInvalidJSON},
+		{`{"amount": null, "currency": "EUR"}`, ErrInvalidJSON},
+		{`{"amount": "1.00", "currency": "EUR"`, ErrInvalidJSON},
+		{`{"amount": "1.00", "currency": "EUR"}x`, ErrInvalidJSON},
+		{`["amount", "currency"]`, ErrInvalidJSON},
+		{``, ErrInvalidJSON},
+	}
+
+	for _, tc := range tcs {
+		var m Money
+		err := UnmarshalJSONStrict(&m, []byte(tc.given))
+		if err == nil || (tc.expected != nil && !errors.Is(err, tc.expected)) {
+			t.Errorf("Expected error %v for %s got %v", tc.expected, tc.given, err)
+		}
+
+		if m != (Money{}) {
+			t.Errorf("Expected Money to be untouched for %s got %+v", tc.given, m)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package money
+
+// StripeUse tells what a Stripe amount is used for, as some currencies follow different rules for payouts.
+type StripeUse int
+
+const (
+	// StripeCharge is an amount used for charges, payment intents and refunds.
+	StripeCharge StripeUse = iota
+	// StripePayout is an amount used for payouts.
+	StripePayout
+)
+
+var (
+	// StripeProfile is the MinorUnitProfile of Stripe charges. MGA is zero-decimal, and ISK and TZS use two
+	// decimal places which must be zero for ISK. Three decimal currencies must end with zero.
+	StripeProfile = MinorUnitProfile{
+		Name:      "stripe",
+		Exponents: map[string]int{ISK: 2, MGA: 0, TZS: 2},
+		Steps:     map[string]int64{ISK: 100, BHD: 10, JOD: 10, KWD: 10, OMR: 10, TND: 10},
+	}
+
+	// StripePayoutProfile is the MinorUnitProfile of Stripe payouts, which additionally
+	// have to be whole units for HUF and TWD.
+	StripePayoutProfile = MinorUnitProfile{
+		Name:      "stripe",
+		Exponents: StripeProfile.Exponents,
+		Steps:     map[string]int64{ISK: 100, BHD: 10, JOD: 10, KWD: 10, OMR: 10, TND: 10, HUF: 100, TWD: 100},
+	}
+)
+
+// ToStripeAmount returns Money as an amount in the smallest unit Stripe uses for the currency, like 1234 for
+// USD 12.34 and 1234 for JPY 1234. It takes care of Stripe's special cases, like ISK using two decimal places
+// which are always zero, three decimal currencies having to end with zero or HUF and TWD payouts having to be
+// whole units, failing instead of rounding when Money doesn't fit them.
+func (m *Money) ToStripeAmount(use StripeUse) (int64, error) {
+	if use == StripePayout {
+		return StripePayoutProfile.ToMinorUnits(m)
+	}
+
+	return StripeProfile.ToMinorUnits(m)
+}
+
+// FromStripeAmount creates and returns new Money from an amount in the smallest unit Stripe uses for the currency.
+// The currency code may be lower case, like Stripe returns it.
+func FromStripeAmount(amount int64, currencyCode string) (*Money, error) {
+	return StripeProfile.FromMinorUnits(amount, currencyCode)
+}
@@ -0,0 +1,92 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMoney_ToStripeAmount(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		use      StripeUse
+		expected int64
+	}{
+		{1234, USD, StripeCharge, 1234},
+		{1234, JPY, StripeCharge, 1234},
+		{1234, KRW, StripeCharge, 1234},
+		{5, ISK, StripeCharge, 500},
+		{1200, MGA, StripeCharge, 12},
+		{12340, BHD, StripeCharge, 12340},
+		{1234, HUF, StripeCharge, 1234},
+		{1200, HUF, StripePayout, 1200},
+		{1500, TWD, StripePayout, 1500},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		r, err := m.ToStripeAmount(tc.use)
+		if err != nil {
+			t.Fatalf("Unexpected error for %d %s: %v", tc.amount, tc.code, err)
+		}
+
+		if r != tc.expected {
+			t.Errorf("Expected %d %s to be %d got %d", tc.amount, tc.code, tc.expected, r)
+		}
+
+		f, err := FromStripeAmount(r, tc.code)
+		if err != nil || *f != *m {
+			t.Errorf("Expected %d %s to map back to %v got %v, %v", r, tc.code, m, f, err)
+		}
+	}
+}
+
+func TestMoney_ToStripeAmount_Errors(t *testing.T) {
+	tcs := []struct {
+		amount int64
+		code   string
+		use    StripeUse
+	}{
+		{1234, MGA, StripeCharge},
+		{12345, BHD, StripeCharge},
+		{1234, HUF, StripePayout},
+		{1550, TWD, StripePayout},
+		{math.MaxInt64, ISK, StripeCharge},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		if r, err := m.ToStripeAmount(tc.use); err == nil {
+			t.Errorf("Expected error for %d %s got %d", tc.amount, tc.code, r)
+		}
+	}
+
+	micros, _ := NewFromMicros(1234567, USD)
+	if _, err := micros.ToStripeAmount(StripeCharge); err == nil {
+		t.Error("Expected error for sub-cent micros")
+	}
+
+	micros, _ = NewFromMicros(1230000, USD)
+	if r, err := micros.ToStripeAmount(StripeCharge); err != nil || r != 123 {
+		t.Errorf("Expected %d got %d, %v", 123, r, err)
+	}
+}
+
+func TestFromStripeAmount(t *testing.T) {
+	m, err := FromStripeAmount(1234, "eur")
+	if err != nil || m.Display() != "€12.34" {
+		t.Errorf("Expected %s got %v, %v", "€12.34", m, err)
+	}
+
+	if _, err := FromStripeAmount(550, "isk"); err == nil {
+		t.Error("Expected error for ISK with decimals")
+	}
+
+	if _, err := FromStripeAmount(math.MaxInt64, MGA); err == nil {
+		t.Error("Expected overflow error")
+	}
+
+	if _, err := FromStripeAmount(1, "xxx"); err == nil {
+		t.Error("Expected error for invalid currency")
+	}
+}
@@ -0,0 +1,63 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// maxSWIFTAmountLength is the length of SWIFT MT amount fields, like the amount of field 32A.
+const maxSWIFTAmountLength = 15
+
+// SWIFTAmount returns Money formatted for SWIFT MT amount fields, as used in MT103 and MT202:
+// a comma as decimal separator which is always present, no grouping and the currency's decimal places,
+// like "1234,56" or "1000," for JPY. It fails if the amount is negative, longer than 15 characters
+// or not in the currency's smallest unit, like micros.
+func (m *Money) SWIFTAmount() (string, error) {
+	c := m.currency.get()
+	if err := c.assertSmallestUnit(); err != nil {
+		return "", err
+	}
+
+	if m.amount < 0 {
+		return "", errors.New("swift amounts can't be negative")
+	}
+
+	b := appendFixed(nil, m.amount, c.Fraction)
+	if c.Fraction == 0 {
+		b = append(b, ',')
+	} else {
+		b[len(b)-c.Fraction-1] = ','
+	}
+
+	if len(b) > maxSWIFTAmountLength {
+		return "", fmt.Errorf("swift amount '%s' is longer than %d characters", b, maxSWIFTAmountLength)
+	}
+
+	return string(b), nil
+}
+
+// ParseSWIFTAmount creates and returns new Money from a SWIFT MT amount field like "1234,56",
+// failing if it's longer than 15 characters or has more decimal places than the currency.
+func ParseSWIFTAmount(amount string, currencyCode string) (*Money, error) {
+	currency := GetCurrency(currencyCode)
+	if currency == nil {
+		return nil, fmt.Errorf("invalid currency '%s'", currencyCode)
+	}
+
+	if len(amount) > maxSWIFTAmountLength {
+		return nil, fmt.Errorf("swift amount '%s' is longer than %d characters", amount, maxSWIFTAmountLength)
+	}
+
+	i := strings.IndexByte(amount, ',')
+	if i < 1 || strings.Trim(amount[:i]+amount[i+1:], "0123456789") != "" {
+		return nil, fmt.Errorf("invalid swift amount '%s'", amount)
+	}
+
+	decimals := amount[i+1:]
+	if len(decimals) > currency.Fraction {
+		return nil, fmt.Errorf("swift amount '%s' has more than %d decimal places", amount, currency.Fraction)
+	}
+
+	return NewFromString(amount[:i]+currency.Decimal+decimals, currencyCode)
+}
@@ -0,0 +1,87 @@
+package money
+
+import "testing"
+
+func TestMoney_SWIFTAmount(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+	}{
+		{123456, EUR, "1234,56"},
+		{5, EUR, "0,05"},
+		{0, USD, "0,00"},
+		{1000, JPY, "1000,"},
+		{1500, BHD, "1,500"},
+		{99999999999999, EUR, "999999999999,99"},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, tc.code)
+		r, err := m.SWIFTAmount()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, r)
+		}
+
+		p, err := ParseSWIFTAmount(r, tc.code)
+		if err != nil || *p != *m {
+			t.Errorf("Expected %s to parse to %v got %v, %v", r, m, p, err)
+		}
+	}
+
+	for _, amount := range []int64{-1, 100000000000000} {
+		m, _ := New(amount, EUR)
+		if _, err := m.SWIFTAmount(); err == nil {
+			t.Errorf("Expected error for %d", amount)
+		}
+	}
+
+	micros, _ := NewFromMicros(1234567, EUR)
+	eur, _ := New(1234, EUR)
+	rescaled, _ := eur.Rescale(4, RoundHalfUp)
+	for _, m := range []*Money{micros, rescaled} {
+		if _, err := m.SWIFTAmount(); err == nil {
+			t.Errorf("Expected error for %v", m)
+		}
+	}
+}
+
+func TestParseSWIFTAmount(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		code     string
+		expected int64
+		err      bool
+	}{
+		{"1234,5", EUR, 123450, false},
+		{"1234,", EUR, 123400, false},
+		{"0001,00", EUR, 100, false},
+		{"1234", EUR, 0, true},
+		{",50", EUR, 0, true},
+		{"1234,567", EUR, 0, true},
+		{"1000,5", JPY, 0, true},
+		{"1.234,56", EUR, 0, true},
+		{"-1,00", EUR, 0, true},
+		{"1,00,0", EUR, 0, true},
+		{"12345678901234,5", EUR, 0, true},
+		{"1,00", "XXX", 0, true},
+	}
+
+	for _, tc := range tcs {
+		m, err := ParseSWIFTAmount(tc.amount, tc.code)
+		if tc.err {
+			if err == nil {
+				t.Errorf("Expected error for %q got %v", tc.amount, m)
+			}
+			continue
+		}
+
+		if err != nil || m.amount != tc.expected {
+			t.Errorf("Expected %q to be %d got %v, %v", tc.amount, tc.expected, m, err)
+		}
+	}
+}
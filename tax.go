@@ -0,0 +1,92 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// ErrNoTaxRate happens when a TaxTable doesn't know the tax rate of a country and category.
+var ErrNoTaxRate = errors.New("no tax rate")
+
+// TaxCategory is a class of goods or services taxed at the same rate within a country.
+type TaxCategory string
+
+// Common VAT categories. Countries may use others, like "parking" rates.
+const (
+	TaxStandard     TaxCategory = "standard"
+	TaxReduced      TaxCategory = "reduced"
+	TaxSuperReduced TaxCategory = "super_reduced"
+	TaxZero         TaxCategory = "zero"
+)
+
+// TaxTable holds VAT or GST rates by ISO 3166 country code and category, so that tax can be driven
+// by jurisdiction instead of hard-coded rates.
+type TaxTable map[string]map[TaxCategory]Rate
+
+// LoadTaxTable reads a TaxTable from JSON data of percentages as decimal strings by country and category, e.g.
+//
+//	{"DE": {"standard": "19", "reduced": "7"}, "FR": {"standard": "20", "reduced": "5.5"}}
+func LoadTaxTable(r io.Reader) (TaxTable, error) {
+	var data map[string]map[TaxCategory]string
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return nil, fmt.Errorf("invalid tax table: %v", err)
+	}
+
+	t := TaxTable{}
+	for country, rates := range data {
+		for category, percent := range rates {
+			r, err := ParseRate(percent)
+			if err != nil || r.Numerator < 0 || r.Denominator > math.MaxInt64/100 {
+				return nil, fmt.Errorf("invalid tax rate '%s' of %s %s", percent, country, category)
+			}
+
+			t.Set(country, category, Rate{Numerator: r.Numerator, Denominator: r.Denominator * 100})
+		}
+	}
+
+	return t, nil
+}
+
+// Set updates the table by adding the tax rate of a category in a country to it.
+func (t TaxTable) Set(country string, category TaxCategory, r Rate) TaxTable {
+	country = strings.ToUpper(country)
+	if t[country] == nil {
+		t[country] = make(map[TaxCategory]Rate)
+	}
+
+	t[country][category] = r
+	return t
+}
+
+// Rate returns the tax rate of a category in a country, the standard rate for an empty category.
+func (t TaxTable) Rate(country string, category TaxCategory) (Rate, error) {
+	if category == "" {
+		category = TaxStandard
+	}
+
+	if r, ok := t[strings.ToUpper(country)][category]; ok {
+		return r, nil
+	}
+
+	return Rate{}, fmt.Errorf("%w for %s in '%s'", ErrNoTaxRate, category, country)
+}
+
+// AddTax returns new Money struct with the tax of the category in the country added to m,
+// the tax being rounded half away from zero.
+func (t TaxTable) AddTax(m *Money, country string, category TaxCategory) (*Money, error) {
+	r, err := t.Rate(country, category)
+	if err != nil {
+		return nil, err
+	}
+
+	tax, err := m.Percent(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.AddAll(tax)
+}
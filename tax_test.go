@@ -0,0 +1,95 @@
+package money
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const taxData = `{
+	"DE": {"standard": "19", "reduced": "7"},
+	"FR": {"standard": "20", "reduced": "5.5", "super_reduced": "2.1"},
+	"AU": {"standard": "10", "zero": "0"}
+}`
+
+func TestLoadTaxTable(t *testing.T) {
+	table, err := LoadTaxTable(strings.NewReader(taxData))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcs := []struct {
+		country  string
+		category TaxCategory
+		expected Rate
+	}{
+		{"DE", TaxStandard, Rate{19, 100}},
+		{"de", "", Rate{19, 100}},
+		{"DE", TaxReduced, Rate{7, 100}},
+		{"FR", TaxReduced, Rate{55, 1000}},
+		{"FR", TaxSuperReduced, Rate{21, 1000}},
+		{"AU", TaxZero, Rate{0, 100}},
+	}
+
+	for _, tc := range tcs {
+		r, err := table.Rate(tc.country, tc.category)
+		if err != nil || r != tc.expected {
+			t.Errorf("Expected %v for %s %s got %v, %v", tc.expected, tc.country, tc.category, r, err)
+		}
+	}
+
+	for _, tc := range []struct {
+		country  string
+		category TaxCategory
+	}{{"AU", TaxReduced}, {"US", TaxStandard}} {
+		if _, err := table.Rate(tc.country, tc.category); !errors.Is(err, ErrNoTaxRate) {
+			t.Errorf("Expected %v for %s %s got %v", ErrNoTaxRate, tc.country, tc.category, err)
+		}
+	}
+
+	for _, data := range []string{`{"DE": {"standard": 19}}`, `{"DE": {"standard": "-19"}}`, `{"DE": {"standard": "1.0000000000000000001"}}`, `[`} {
+		if _, err := LoadTaxTable(strings.NewReader(data)); err == nil {
+			t.Errorf("Expected error for %s", data)
+		}
+	}
+}
+
+func TestTaxTable_AddTax(t *testing.T) {
+	table := TaxTable{}.Set("FR", TaxReduced, Rate{55, 1000})
+	m, _ := New(999, EUR)
+
+	r, err := table.AddTax(m, "FR", TaxReduced)
+	if err != nil || r.Display() != "€10.54" {
+		t.Errorf("Expected €10.54 got %v, %v", r, err)
+	}
+
+	if _, err := table.AddTax(m, "FR", TaxStandard); !errors.Is(err, ErrNoTaxRate) {
+		t.Errorf("Expected %v got %v", ErrNoTaxRate, err)
+	}
+}
+
+func TestInvoice_ApplyTaxTable(t *testing.T) {
+	table, _ := LoadTaxTable(strings.NewReader(taxData))
+	price, _ := New(1000, EUR)
+
+	inv, _ := NewInvoice(EUR, TaxPerLine)
+	inv.AddLine(&LineItem{Description: "book", UnitPrice: price, Quantity: 1, TaxCategory: TaxReduced}).
+		AddLine(&LineItem{Description: "pen", UnitPrice: price, Quantity: 2})
+
+	if err := inv.ApplyTaxTable(table, "DE"); err != nil {
+		t.Fatal(err)
+	}
+
+	if tax, err := inv.Tax(); err != nil || tax.Display() != "€4.50" {
+		t.Errorf("Expected €4.50 got %v, %v", tax, err)
+	}
+
+	inv.AddLine(&LineItem{Description: "food", UnitPrice: price, Quantity: 1, TaxCategory: TaxSuperReduced})
+	if err := inv.ApplyTaxTable(table, "DE"); !errors.Is(err, ErrNoTaxRate) {
+		t.Errorf("Expected %v got %v", ErrNoTaxRate, err)
+	}
+
+	if inv.Lines[0].TaxRate != (Rate{7, 100}) || !inv.Lines[2].TaxRate.IsZero() {
+		t.Errorf("Expected lines to be left untouched on error got %v and %v", inv.Lines[0].TaxRate, inv.Lines[2].TaxRate)
+	}
+}
@@ -0,0 +1,125 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+)
+
+// TierMode decides how a TierTable prices a quantity spanning several tiers.
+type TierMode int
+
+const (
+	// TierGraduated prices every unit at the tier it falls in, e.g. the first 10 units at €5.00
+	// and the following ones at €4.00.
+	TierGraduated TierMode = iota
+	// TierVolume prices all units at the tier the whole quantity falls in, e.g. 15 units all at €4.00.
+	TierVolume
+)
+
+// Tier is a level of a TierTable, covering quantities above the previous tier up to and including UpTo.
+// An UpTo of zero makes the last tier unbounded.
+type Tier struct {
+	UpTo      int64
+	UnitPrice *Money
+}
+
+// TierTable maps quantity ranges to unit prices, like the price list of a metered API or a wholesale catalog.
+type TierTable struct {
+	mode  TierMode
+	tiers []Tier
+}
+
+// NewTierTable creates and returns new TierTable of the given tiers, in ascending order of UpTo.
+// Unit prices must be in one currency and not negative.
+func NewTierTable(mode TierMode, tiers ...Tier) (*TierTable, error) {
+	if mode != TierGraduated && mode != TierVolume {
+		return nil, errors.New("unknown tier mode")
+	}
+
+	if len(tiers) == 0 {
+		return nil, errors.New("tier table needs at least one tier")
+	}
+
+	for i, t := range tiers {
+		if t.UnitPrice == nil {
+			return nil, fmt.Errorf("tier %d has no unit price", i)
+		}
+
+		if err := tiers[0].UnitPrice.assertSameCurrency(t.UnitPrice); err != nil {
+			return nil, err
+		}
+
+		if t.UnitPrice.IsNegative() {
+			return nil, fmt.Errorf("tier %d has negative unit price", i)
+		}
+
+		if t.UpTo == 0 && i == len(tiers)-1 {
+			continue
+		}
+
+		if t.UpTo <= 0 || (i > 0 && t.UpTo <= tiers[i-1].UpTo) {
+			return nil, errors.New("tier bounds must be positive and strictly ascending")
+		}
+	}
+
+	return &TierTable{mode: mode, tiers: tiers}, nil
+}
+
+// Lookup returns the tier the given quantity falls in, failing for negative quantities
+// and quantities above the last bounded tier.
+func (t *TierTable) Lookup(quantity int64) (Tier, error) {
+	if quantity < 0 {
+		return Tier{}, errors.New("quantity must not be negative")
+	}
+
+	for _, tier := range t.tiers {
+		if tier.UpTo == 0 || quantity <= tier.UpTo {
+			return tier, nil
+		}
+	}
+
+	return Tier{}, fmt.Errorf("quantity %d exceeds the last tier", quantity)
+}
+
+// TotalFor returns the price of the given quantity, according to the mode of the table.
+// A total not fitting into an Amount fails with ErrOverflow.
+func (t *TierTable) TotalFor(quantity int64) (*Money, error) {
+	tier, err := t.Lookup(quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.mode == TierVolume {
+		a, err := mutate.calc.mulDivRound(tier.UnitPrice.amount, quantity, 1, RoundHalfUp)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Money{amount: a, currency: tier.UnitPrice.currency}, nil
+	}
+
+	total := tier.UnitPrice.WithAmount(0)
+	var below int64
+	for _, tier := range t.tiers {
+		units := quantity - below
+		if tier.UpTo != 0 && tier.UpTo < quantity {
+			units = tier.UpTo - below
+		}
+
+		a, err := mutate.calc.mulDivRound(tier.UnitPrice.amount, units, 1, RoundHalfUp)
+		if err != nil {
+			return nil, err
+		}
+
+		if total, err = total.AddAll(&Money{amount: a, currency: tier.UnitPrice.currency}); err != nil {
+			return nil, err
+		}
+
+		if tier.UpTo == 0 || tier.UpTo >= quantity {
+			break
+		}
+		below = tier.UpTo
+	}
+
+	return total, nil
+}
@@ -0,0 +1,105 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func tiers(t *testing.T, mode TierMode) *TierTable {
+	five, _ := New(500, EUR)
+	four, _ := New(400, EUR)
+	three, _ := New(300, EUR)
+
+	tt, err := NewTierTable(mode, Tier{UpTo: 10, UnitPrice: five}, Tier{UpTo: 100, UnitPrice: four}, Tier{UnitPrice: three})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tt
+}
+
+func TestTierTable_TotalFor(t *testing.T) {
+	tcs := []struct {
+		mode     TierMode
+		quantity int64
+		expected string
+	}{
+		{TierGraduated, 0, "€0.00"},
+		{TierGraduated, 7, "€35.00"},
+		{TierGraduated, 10, "€50.00"},
+		{TierGraduated, 15, "€70.00"},
+		{TierGraduated, 100, "€410.00"},
+		{TierGraduated, 150, "€560.00"},
+		{TierVolume, 0, "€0.00"},
+		{TierVolume, 10, "€50.00"},
+		{TierVolume, 15, "€60.00"},
+		{TierVolume, 150, "€450.00"},
+	}
+
+	for _, tc := range tcs {
+		m, err := tiers(t, tc.mode).TotalFor(tc.quantity)
+		if err != nil || m.Display() != tc.expected {
+			t.Errorf("Expected %s for %d in mode %d got %v, %v", tc.expected, tc.quantity, tc.mode, m, err)
+		}
+	}
+}
+
+func TestTierTable_Lookup(t *testing.T) {
+	tt := tiers(t, TierGraduated)
+
+	tcs := []struct {
+		quantity int64
+		expected string
+	}{
+		{0, "€5.00"},
+		{10, "€5.00"},
+		{11, "€4.00"},
+		{math.MaxInt64, "€3.00"},
+	}
+
+	for _, tc := range tcs {
+		tier, err := tt.Lookup(tc.quantity)
+		if err != nil || tier.UnitPrice.Display() != tc.expected {
+			t.Errorf("Expected %s for %d got %+v, %v", tc.expected, tc.quantity, tier, err)
+		}
+	}
+
+	if _, err := tt.Lookup(-1); err == nil {
+		t.Error("Expected error for negative quantity")
+	}
+
+	if _, err := tt.TotalFor(math.MaxInt64); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func TestNewTierTable_Errors(t *testing.T) {
+	five, _ := New(500, EUR)
+	negative, _ := New(-500, EUR)
+	usd, _ := New(500, USD)
+
+	tcs := []struct {
+		mode  TierMode
+		tiers []Tier
+	}{
+		{TierMode(7), []Tier{{UnitPrice: five}}},
+		{TierGraduated, nil},
+		{TierGraduated, []Tier{{UpTo: 10}}},
+		{TierGraduated, []Tier{{UpTo: 10, UnitPrice: five}, {UnitPrice: usd}}},
+		{TierGraduated, []Tier{{UpTo: 10, UnitPrice: negative}}},
+		{TierGraduated, []Tier{{UpTo: 10, UnitPrice: five}, {UpTo: 10, UnitPrice: five}}},
+		{TierGraduated, []Tier{{UnitPrice: five}, {UpTo: 10, UnitPrice: five}}},
+	}
+
+	for _, tc := range tcs {
+		if _, err := NewTierTable(tc.mode, tc.tiers...); err == nil {
+			t.Errorf("Expected error for %+v", tc.tiers)
+		}
+	}
+
+	tt, _ := NewTierTable(TierVolume, Tier{UpTo: 10, UnitPrice: five})
+	if _, err := tt.TotalFor(11); err == nil {
+		t.Error("Expected error for quantity above the last tier")
+	}
+}
@@ -0,0 +1,36 @@
+package money
+
+import "errors"
+
+// TipSplit is the result of splitting a bill with tip among diners.
+type TipSplit struct {
+	Tip    *Money
+	Total  *Money
+	Shares []*Money
+}
+
+// SplitWithTip adds the given tip Rate to the bill and splits bill and tip among n diners.
+// The tip itself is rounded half away from zero, the leftover pennies of the split are
+// distributed according to strategy.
+func (m *Money) SplitWithTip(tip Rate, n int, strategy RemainderStrategy) (*TipSplit, error) {
+	if n <= 0 {
+		return nil, errors.New("number of diners must be higher than zero")
+	}
+
+	t, err := m.Percent(tip)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := m.Add(t)
+	if err != nil {
+		return nil, err
+	}
+
+	as, err := mutate.calc.distribute(total.amount, n, strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TipSplit{Tip: t, Total: total, Shares: m.parts(as)}, nil
+}
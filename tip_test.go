@@ -0,0 +1,62 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestMoney_SplitWithTip(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		tip      Rate
+		n        int
+		strategy RemainderStrategy
+		tipped   int64
+		shares   []int64
+	}{
+		{10000, Rate{15, 100}, 3, RemainderRoundRobin, 1500, []int64{3834, 3833, 3833}},
+		{10000, Rate{15, 100}, 3, RemainderLast, 1500, []int64{3833, 3833, 3834}},
+		{4999, Rate{18, 100}, 4, RemainderFirst, 900, []int64{1477, 1474, 1474, 1474}},
+		{4999, Rate{125, 1000}, 2, RemainderRoundRobin, 625, []int64{2812, 2812}},
+		{1000, Rate{}, 3, RemainderRoundRobin, 0, []int64{334, 333, 333}},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, USD)
+		r, err := m.SplitWithTip(tc.tip, tc.n, tc.strategy)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.Tip.amount != tc.tipped {
+			t.Errorf("Expected tip %d got %d", tc.tipped, r.Tip.amount)
+		}
+
+		if r.Total.amount != tc.amount+tc.tipped {
+			t.Errorf("Expected total %d got %d", tc.amount+tc.tipped, r.Total.amount)
+		}
+
+		var sum int64
+		for i, s := range r.Shares {
+			sum += s.amount
+			if s.amount != tc.shares[i] {
+				t.Errorf("Expected share %d to be %d got %d", i, tc.shares[i], s.amount)
+			}
+		}
+
+		if sum != r.Total.amount {
+			t.Errorf("Expected shares to sum up to %d got %d", r.Total.amount, sum)
+		}
+	}
+}
+
+func TestMoney_SplitWithTip2(t *testing.T) {
+	m, _ := New(100, USD)
+
+	if _, err := m.SplitWithTip(Rate{15, 100}, 0, RemainderFirst); err == nil {
+		t.Error("Expected error for zero diners")
+	}
+
+	if _, err := m.SplitWithTip(Rate{15, 0}, 2, RemainderFirst); err == nil {
+		t.Error("Expected error for invalid rate")
+	}
+}
@@ -0,0 +1,75 @@
+package money
+
+import "fmt"
+
+// Totals accumulates Money by key, like the revenue per merchant ID or the refunds per country.
+// Every key has a single currency, set by the first amount added to it. The zero value is empty and ready to use.
+type Totals[K comparable] struct {
+	totals map[K]*Money
+	keys   []K
+}
+
+// Add adds m to the total of key, failing with ErrCurrencyMismatch if key already has a total in another currency
+// and with ErrOverflow instead of wrapping around.
+func (t *Totals[K]) Add(key K, m *Money) error {
+	return t.apply(key, m, (*Money).AddAll)
+}
+
+// Subtract subtracts m from the total of key, see Add.
+func (t *Totals[K]) Subtract(key K, m *Money) error {
+	return t.apply(key, m, (*Money).SubtractAll)
+}
+
+func (t *Totals[K]) apply(key K, m *Money, op func(*Money, ...*Money) (*Money, error)) error {
+	if m.currency == nil {
+		return fmt.Errorf("invalid currency '%s'", m.CurrencyCode())
+	}
+
+	total, ok := t.totals[key]
+	if !ok {
+		total = m.WithAmount(0)
+	}
+
+	r, err := op(total, m)
+	if err != nil {
+		return fmt.Errorf("total '%v': %w", key, err)
+	}
+
+	if t.totals == nil {
+		t.totals = map[K]*Money{}
+	}
+	if !ok {
+		t.keys = append(t.keys, key)
+	}
+	t.totals[key] = r
+
+	return nil
+}
+
+// Get returns the total of key, or nil if nothing was added to it.
+func (t *Totals[K]) Get(key K) *Money {
+	return t.totals[key]
+}
+
+// Keys returns the keys with a total in the order they were first added, so that reports iterate
+// deterministically. Sort them with sort.Slice for another order.
+func (t *Totals[K]) Keys() []K {
+	return append([]K(nil), t.keys...)
+}
+
+// Len returns the number of keys with a total.
+func (t *Totals[K]) Len() int {
+	return len(t.totals)
+}
+
+// ByCurrency returns the sum of the totals of all keys per currency, e.g. for the grand total line of a report.
+func (t *Totals[K]) ByCurrency() (Amounts, error) {
+	var a Amounts
+	for _, key := range t.keys {
+		if err := a.Add(t.totals[key]); err != nil {
+			return Amounts{}, err
+		}
+	}
+
+	return a, nil
+}
@@ -0,0 +1,125 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTotals(t *testing.T) {
+	entries := []struct {
+		key    string
+		amount int64
+		code   string
+	}{
+		{"merchant-2", 1000, EUR},
+		{"merchant-1", 500, USD},
+		{"merchant-2", -250, EUR},
+		{"merchant-3", 200, EUR},
+		{"merchant-1", 125, USD},
+	}
+
+	var totals Totals[string]
+	for _, e := range entries {
+		m, _ := New(e.amount, e.code)
+		if err := totals.Add(e.key, m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	refund, _ := New(100, EUR)
+	if err := totals.Subtract("merchant-3", refund); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"merchant-2", "merchant-1", "merchant-3"}
+	if keys := totals.Keys(); !reflect.DeepEqual(keys, expected) || totals.Len() != 3 {
+		t.Errorf("Expected %v got %v", expected, keys)
+	}
+
+	displays := map[string]string{"merchant-1": "$6.25", "merchant-2": "€7.50", "merchant-3": "€1.00"}
+	for key, d := range displays {
+		if m := totals.Get(key); m == nil || m.Display() != d {
+			t.Errorf("Expected %s for %s got %v", d, key, m)
+		}
+	}
+
+	if totals.Get("merchant-4") != nil {
+		t.Error("Expected no total for unknown key")
+	}
+
+	a, err := totals.ByCurrency()
+	if err != nil || a.Get(EUR).Display() != "€8.50" || a.Get(USD).Display() != "$6.25" {
+		t.Errorf("Expected €8.50 and $6.25 got %v, %v", a.Get(EUR), err)
+	}
+}
+
+func TestTotals_Errors(t *testing.T) {
+	var totals Totals[string]
+	eur, _ := New(math.MaxInt64, EUR)
+	usd, _ := New(1, USD)
+	_ = totals.Add("a", eur)
+
+	if err := totals.Add("a", usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if err := totals.Add("a", eur); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if err := totals.Add("b", &Money{}); err == nil || totals.Len() != 1 {
+		t.Error("Expected error for zero value Money")
+	}
+
+	_ = totals.Add("b", eur)
+	if _, err := totals.ByCurrency(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if totals.Get("a").amount != math.MaxInt64 {
+		t.Errorf("Expected failed operations to leave the total untouched got %d", totals.Get("a").amount)
+	}
+}
+
+func TestTotals_StructKeys(t *testing.T) {
+	type key struct {
+		country  string
+		merchant int
+	}
+
+	entries := []struct {
+		key    key
+		amount int64
+		code   string
+	}{
+		{key{"SE", 2}, 1000, SEK},
+		{key{"DE", 1}, 500, EUR},
+		{key{"SE", 2}, 250, SEK},
+		{key{"DE", 2}, 200, EUR},
+	}
+
+	var totals Totals[key]
+	for _, e := range entries {
+		m, _ := New(e.amount, e.code)
+		if err := totals.Add(e.key, m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := []key{{"SE", 2}, {"DE", 1}, {"DE", 2}}
+	if keys := totals.Keys(); !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Expected %v got %v", expected, keys)
+	}
+
+	if m := totals.Get(key{"SE", 2}); m == nil || m.amount != 1250 {
+		t.Errorf("Expected 1250 got %v", m)
+	}
+
+	eur, _ := New(1, EUR)
+	if err := totals.Add(key{"SE", 2}, eur); !errors.Is(err, ErrCurrencyMismatch) || !strings.Contains(err.Error(), "{SE 2}") {
+		t.Errorf("Expected %v for {SE 2} got %v", ErrCurrencyMismatch, err)
+	}
+}
@@ -0,0 +1,56 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+)
+
+// UnitPrice is the price of a single unit of goods sold in fractional quantities, e.g. €3.99/kg.
+// Price may use more decimal places than its currency, e.g. a price in micros for electricity per kWh.
+type UnitPrice struct {
+	Price *Money
+	Unit  string
+}
+
+// NewUnitPrice creates and returns new instance of UnitPrice.
+func NewUnitPrice(price *Money, unit string) *UnitPrice {
+	return &UnitPrice{Price: price, Unit: unit}
+}
+
+// Total returns the price of the given decimal quantity, e.g. "2.345" kg, in the standard fraction of the currency.
+// The exact product is rounded once, using mode, failing with ErrOverflow when it doesn't fit into an Amount.
+func (p *UnitPrice) Total(quantity string, mode RoundingMode) (*Money, error) {
+	q, err := ParseRate(quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.TotalRate(q, mode)
+}
+
+// TotalRate works like Total with the quantity given as a Rate, e.g. Rate{2345, 1000} kg.
+func (p *UnitPrice) TotalRate(quantity Rate, mode RoundingMode) (*Money, error) {
+	if quantity.Denominator <= 0 {
+		return nil, errors.New("quantity denominator must be higher than zero")
+	}
+
+	if err := mode.validate(); err != nil {
+		return nil, err
+	}
+
+	currency := newCurrency(p.Price.CurrencyCode()).get()
+	n := new(big.Int).Mul(big.NewInt(p.Price.amount), big.NewInt(quantity.Numerator))
+	d := big.NewInt(quantity.Denominator)
+	if e := currency.Fraction - p.Price.currency.get().Fraction; e > 0 {
+		n.Mul(n, pow10(e))
+	} else if e < 0 {
+		d.Mul(d, pow10(-e))
+	}
+
+	a, err := roundRat(new(big.Rat).SetFrac(n, d), mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: a, currency: currency}, nil
+}
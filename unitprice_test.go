@@ -0,0 +1,61 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestUnitPrice_Total(t *testing.T) {
+	perKg, _ := New(399, EUR)
+	perKWh, _ := NewFromMicros(123456, EUR)
+
+	tcs := []struct {
+		price    *Money
+		quantity string
+		mode     RoundingMode
+		expected int64
+	}{
+		// 2.345 * 3.99 = 9.35655
+		{perKg, "2.345", RoundHalfUp, 936},
+		{perKg, "2.345", RoundDown, 935},
+		{perKg, "3", RoundHalfUp, 1197},
+		{perKg, "-1.5", RoundHalfUp, -599},
+		// 100.5 * 0.123456 = 12.407328
+		{perKWh, "100.5", RoundHalfUp, 1241},
+		{perKWh, "100.5", RoundCeiling, 1241},
+		{perKWh, "100.5", RoundFloor, 1240},
+	}
+
+	for _, tc := range tcs {
+		r, err := NewUnitPrice(tc.price, "kg").Total(tc.quantity, tc.mode)
+		if err != nil || r.amount != tc.expected || r.currency != GetCurrency(EUR) {
+			t.Errorf("Expected %s x %d to be %d got %v (%v)", tc.quantity, tc.price.amount, tc.expected, r, err)
+		}
+	}
+
+	p := NewUnitPrice(perKg, "kg")
+	if _, err := p.Total("abc", RoundHalfUp); err == nil {
+		t.Error("Expected error for invalid quantity")
+	}
+
+	if _, err := p.Total("1", RoundingMode(42)); err == nil {
+		t.Error("Expected error for unknown rounding mode")
+	}
+
+	if _, err := p.TotalRate(Rate{1, 0}, RoundHalfUp); err == nil {
+		t.Error("Expected error for invalid quantity")
+	}
+
+	// The product of the price in micros and the quantity exceeds an int64.
+	large, _ := NewFromMicros(12345678901234, USD)
+	r, err := NewUnitPrice(large, "kWh").TotalRate(Rate{2345000, 1000000}, RoundHalfUp)
+	if err != nil || r.amount != 2895061702 {
+		t.Errorf("Expected %d got %v (%v)", 2895061702, r, err)
+	}
+
+	max, _ := New(math.MaxInt64, EUR)
+	if _, err := NewUnitPrice(max, "kg").Total("2", RoundHalfUp); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
@@ -0,0 +1,121 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrUnsupportedFormat happens when a versioned document uses a format version this package doesn't know.
+var ErrUnsupportedFormat = errors.New("unsupported money format version")
+
+// FormatVersion identifies a canonical encoding of Money for long-lived documents.
+type FormatVersion int
+
+const (
+	// FormatV1 is the default JSON encoding with the amount as decimal string,
+	// e.g. {"version": 1, "amount": "12.34", "currency": "EUR"}.
+	FormatV1 FormatVersion = 1
+	// FormatV2 encodes the amount as integer in the smallest unit of the currency,
+	// e.g. {"version": 2, "amount": 1234, "currency": "EUR"}.
+	FormatV2 FormatVersion = 2
+
+	// FormatLatest is the newest format version.
+	FormatLatest = FormatV2
+)
+
+// MarshalVersioned returns Money encoded in the given format version, recording the version in the document
+// so that UnmarshalVersioned can read it back after the default format changed.
+// Zero value Money is encoded with an empty currency. Neither version carries the fraction, so amounts not in the
+// currency's smallest unit, like micros, fail.
+func MarshalVersioned(m *Money, v FormatVersion) ([]byte, error) {
+	if v != FormatV1 && v != FormatV2 {
+		return nil, fmt.Errorf("%w %d", ErrUnsupportedFormat, v)
+	}
+
+	if m.currency != nil {
+		if err := m.currency.get().assertSmallestUnit(); err != nil {
+			return nil, err
+		}
+	}
+
+	if v == FormatV1 {
+		if m.currency == nil {
+			return []byte(`{"version": 1, "amount": "", "currency": ""}`), nil
+		}
+
+		return []byte(fmt.Sprintf(`{"version": 1, "amount": "%s", "currency": "%s"}`, m.Amount(), m.CurrencyCode())), nil
+	}
+
+	return []byte(fmt.Sprintf(`{"version": 2, "amount": %d, "currency": "%s"}`, m.amount, m.CurrencyCode())), nil
+}
+
+// UnmarshalVersioned parses Money from a document written by MarshalVersioned and returns it with its format version.
+// Documents without version are read as FormatV1, so that Money stored with the default JSON encoding stays readable.
+// Currency codes are normalized like the default JSON encoding does, whatever the version.
+func UnmarshalVersioned(b []byte) (*Money, FormatVersion, error) {
+	var data struct {
+		Version  *FormatVersion  `json:"version"`
+		Amount   json.RawMessage `json:"amount"`
+		Currency jsonString      `json:"currency"`
+	}
+
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, 0, err
+	}
+
+	v := FormatV1
+	if data.Version != nil {
+		v = *data.Version
+	}
+
+	m := &Money{}
+	switch v {
+	case FormatV1:
+		if err := unmarshalJSON(m, b); err != nil {
+			return nil, 0, err
+		}
+	case FormatV2:
+		amount, err := strconv.ParseInt(string(data.Amount), 10, 64)
+		if err != nil {
+			return nil, 0, ErrInvalidJSON
+		}
+
+		if code := normalizeCurrencyCode(string(data.Currency)); code != "" {
+			currency := GetCurrency(code)
+			if currency == nil {
+				return nil, 0, fmt.Errorf("invalid currency '%s'", code)
+			}
+
+			m = &Money{amount: amount, currency: currency}
+		} else if amount != 0 {
+			return nil, 0, ErrInvalidJSON
+		}
+	default:
+		return nil, 0, fmt.Errorf("%w %d", ErrUnsupportedFormat, v)
+	}
+
+	return m, v, nil
+}
+
+// NegotiateFormat returns the newest format version both this package and the peer accept,
+// failing with ErrUnsupportedFormat when there is none. A peer accepting nothing gets FormatV1.
+func NegotiateFormat(accepted ...FormatVersion) (FormatVersion, error) {
+	if len(accepted) == 0 {
+		return FormatV1, nil
+	}
+
+	var best FormatVersion
+	for _, v := range accepted {
+		if v >= FormatV1 && v <= FormatLatest && v > best {
+			best = v
+		}
+	}
+
+	if best == 0 {
+		return 0, fmt.Errorf("%w %v", ErrUnsupportedFormat, accepted)
+	}
+
+	return best, nil
+}
@@ -0,0 +1,102 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMarshalVersioned(t *testing.T) {
+	eur, _ := New(-1234, EUR)
+	micros, _ := NewFromMicros(1250000, USD)
+
+	tcs := []struct {
+		m        *Money
+		v        FormatVersion
+		expected string
+	}{
+		{eur, FormatV1, `{"version": 1, "amount": "-12.34", "currency": "EUR"}`},
+		{eur, FormatV2, `{"version": 2, "amount": -1234, "currency": "EUR"}`},
+		{&Money{}, FormatV1, `{"version": 1, "amount": "", "currency": ""}`},
+		{&Money{}, FormatV2, `{"version": 2, "amount": 0, "currency": ""}`},
+	}
+
+	for _, tc := range tcs {
+		b, err := MarshalVersioned(tc.m, tc.v)
+		if err != nil || string(b) != tc.expected {
+			t.Errorf("Expected %s got %s, %v", tc.expected, b, err)
+			continue
+		}
+
+		m, v, err := UnmarshalVersioned(b)
+		if err != nil || v != tc.v || *m != *tc.m {
+			t.Errorf("Expected %+v in v%d got %+v in v%d, %v", tc.m, tc.v, m, v, err)
+		}
+	}
+
+	rescaled, _ := eur.Rescale(4, RoundHalfUp)
+	for _, m := range []*Money{micros, rescaled} {
+		for _, v := range []FormatVersion{FormatV1, FormatV2} {
+			if _, err := MarshalVersioned(m, v); err == nil {
+				t.Errorf("Expected error for %v in v%d", m, v)
+			}
+		}
+	}
+
+	if _, err := MarshalVersioned(eur, 3); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedFormat, err)
+	}
+}
+
+func TestUnmarshalVersioned(t *testing.T) {
+	m, v, err := UnmarshalVersioned([]byte(`{"amount": "100.12", "currency": "USD"}`))
+	if err != nil || v != FormatV1 || m.Display() != "$100.12" {
+		t.Errorf("Expected $100.12 in v1 got %v in v%d, %v", m, v, err)
+	}
+
+	// Currency codes are normalized in every version.
+	for _, doc := range []string{`{"version": 1, "amount": "12.34", "currency": " eur"}`, `{"version": 2, "amount": 1234, "currency": " eur"}`} {
+		if m, _, err := UnmarshalVersioned([]byte(doc)); err != nil || m.Display() != "€12.34" {
+			t.Errorf("Expected €12.34 for %s got %v, %v", doc, m, err)
+		}
+	}
+
+	tcs := []string{
+		`{"version": 2, "amount": "1234", "currency": "EUR"}`,
+		`{"version": 2, "amount": 12.34, "currency": "EUR"}`,
+		`{"version": 2, "amount": 1234, "currency": "XXX"}`,
+		`{"version": 2, "amount": 1234, "currency": ""}`,
+		`{"version": 1, "amount": "foo", "currency": "EUR"}`,
+		`{"version": 3, "amount": 1234, "currency": "EUR"}`,
+		`{"version": "2"}`,
+		`[]`,
+	}
+
+	for _, tc := range tcs {
+		if m, _, err := UnmarshalVersioned([]byte(tc)); err == nil {
+			t.Errorf("Expected error for %s got %+v", tc, m)
+		}
+	}
+}
+
+func TestNegotiateFormat(t *testing.T) {
+	tcs := []struct {
+		accepted []FormatVersion
+		expected FormatVersion
+	}{
+		{nil, FormatV1},
+		{[]FormatVersion{FormatV1}, FormatV1},
+		{[]FormatVersion{FormatV2, FormatV1}, FormatV2},
+		{[]FormatVersion{FormatV1, 7, FormatV2}, FormatV2},
+	}
+
+	for _, tc := range tcs {
+		v, err := NegotiateFormat(tc.accepted...)
+		if err != nil || v != tc.expected {
+			t.Errorf("Expected v%d got v%d, %v", tc.expected, v, err)
+		}
+	}
+
+	if _, err := NegotiateFormat(0, 7); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedFormat, err)
+	}
+}
@@ -0,0 +1,53 @@
+package money
+
+import (
+	"errors"
+)
+
+// Tranche is a single level of a waterfall allocation, filled up to Cap.
+// A nil Cap means the tranche takes everything left.
+type Tranche struct {
+	Name string
+	Cap  *Money
+}
+
+// WaterfallResult is the outcome of a waterfall allocation.
+type WaterfallResult struct {
+	// Tranches holds the amount allocated to every tranche, in the order given.
+	Tranches []*Money
+	// Remainder is the amount left once all tranches are filled.
+	Remainder *Money
+}
+
+// Waterfall allocates Self to the given tranches in order, filling each one up to its cap before
+// moving to the next, like debt repayment priorities or commission tiers.
+func (m *Money) Waterfall(tranches ...Tranche) (*WaterfallResult, error) {
+	if m.IsNegative() {
+		return nil, errors.New("can't allocate negative amount")
+	}
+
+	rest := m.amount
+	res := &WaterfallResult{Tranches: make([]*Money, len(tranches))}
+	for i, t := range tranches {
+		a := rest
+		if t.Cap != nil {
+			if err := m.assertSameCurrency(t.Cap); err != nil {
+				return nil, err
+			}
+
+			if t.Cap.IsNegative() {
+				return nil, errors.New("tranche cap must not be negative")
+			}
+
+			if t.Cap.amount < a {
+				a = t.Cap.amount
+			}
+		}
+
+		res.Tranches[i] = &Money{amount: a, currency: m.currency}
+		rest = mutate.calc.subtract(rest, a)
+	}
+
+	res.Remainder = &Money{amount: rest, currency: m.currency}
+	return res, nil
+}
@@ -0,0 +1,64 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMoney_Waterfall(t *testing.T) {
+	tcs := []struct {
+		amount    int64
+		caps      []int64
+		expected  []int64
+		remainder int64
+	}{
+		{1000, []int64{300, 500, 400}, []int64{300, 500, 200}, 0},
+		{1500, []int64{300, 500, 400}, []int64{300, 500, 400}, 300},
+		{200, []int64{300, 500}, []int64{200, 0}, 0},
+		{1500, []int64{300, -1}, []int64{300, 1200}, 0},
+		{0, []int64{300}, []int64{0}, 0},
+	}
+
+	for _, tc := range tcs {
+		m, _ := New(tc.amount, EUR)
+		ts := make([]Tranche, len(tc.caps))
+		for i, c := range tc.caps {
+			if c >= 0 {
+				ts[i].Cap, _ = New(c, EUR)
+			}
+		}
+
+		r, err := m.Waterfall(ts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, p := range r.Tranches {
+			if p.amount != tc.expected[i] {
+				t.Errorf("Expected tranche %d of %d to get %d got %d", i, tc.amount, tc.expected[i], p.amount)
+			}
+		}
+
+		if r.Remainder.amount != tc.remainder {
+			t.Errorf("Expected remainder of %d got %d", tc.remainder, r.Remainder.amount)
+		}
+	}
+}
+
+func TestMoney_Waterfall2(t *testing.T) {
+	m, _ := New(1000, EUR)
+	usd, _ := New(100, USD)
+	negative, _ := New(-100, EUR)
+
+	if _, err := m.Waterfall(Tranche{Cap: usd}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := m.Waterfall(Tranche{Cap: negative}); err == nil {
+		t.Error("Expected error for negative cap")
+	}
+
+	if _, err := negative.Waterfall(Tranche{}); err == nil {
+		t.Error("Expected error for negative amount")
+	}
+}